@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// subrecordLayout returns the layout to annotate sub with: its decoder's,
+// if it has one and sub decodes, otherwise adHoc. parsed is the decoded
+// subrecord, if the decoder's layout is used.
+func subrecordLayout(rec esm.RecordTag, sub *esm.Subrecord, adHoc esm.Layout) (layout esm.Layout, parsed esm.ParsedSubrecord) {
+	p, err := esm.DecodeSubrecord(rec, sub)
+	if err != nil {
+		return adHoc, nil
+	}
+	structured, ok := p.(esm.Structured)
+	if !ok {
+		return adHoc, nil
+	}
+	return structured.Layout(), p
+}
+
+// printAnnotated prints each field of layout over data: its byte range,
+// name, type and value, then its bytes. Values come from the fields of
+// parsed where it has them, so flags and enums print by name. Bytes the
+// layout doesn't cover are printed as plain hex.
+func printAnnotated(width int, layout esm.Layout, parsed esm.ParsedSubrecord, data []byte) error {
+	end := 0
+	for _, f := range layout {
+		raw := f.Bytes(data)
+		if len(raw) == 0 && f.Size != 0 {
+			fmt.Printf("    %04x      %-12s %-6s missing\n", f.Offset, f.Name, layoutTypeName(f))
+			continue
+		}
+		value, err := fieldValue(f, parsed, data)
+		text := ""
+		if err != nil {
+			text = "💀 " + err.Error()
+		} else {
+			text = formatValue(value)
+		}
+		fmt.Printf("    %04x-%04x %-12s %-6s %s\n", f.Offset, f.Offset+len(raw)-1, f.Name, layoutTypeName(f), text)
+		printHexRows(width, "      ", raw)
+		end = max(end, f.Offset+len(raw))
+	}
+	if end < len(data) {
+		fmt.Printf("    %04x-%04x not in layout\n", end, len(data)-1)
+		return printHex(width, data[end:])
+	}
+	return nil
+}
+
+// layoutTypeName names the type of f the way ParseLayout spells it.
+func layoutTypeName(f esm.LayoutField) string {
+	switch {
+	case f.Count > 0:
+		return fmt.Sprintf("%s[%d]", f.Type, f.Count)
+	case f.Size < 0:
+		return f.Type
+	case f.Type == "z" || f.Type == "x":
+		return fmt.Sprintf("%s%d", f.Type, f.Size)
+	}
+	return f.Type
+}
+
+// fieldValue is the value of the field of parsed named by f, or failing
+// that, f decoded from data.
+func fieldValue(f esm.LayoutField, parsed esm.ParsedSubrecord, data []byte) (any, error) {
+	if parsed != nil {
+		v := reflect.Indirect(reflect.ValueOf(parsed))
+		if v.Kind() == reflect.Struct {
+			if field := v.FieldByName(f.Name); field.IsValid() && field.CanInterface() {
+				return field.Interface(), nil
+			}
+		}
+	}
+	return f.Value(data)
+}
+
+func formatValue(value any) string {
+	switch v := value.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case []byte:
+		return "0x" + hex.EncodeToString(v)
+	case fmt.Stringer:
+		return v.String()
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// printHexRows prints raw as hex after indent, wrapping it over as many
+// lines as it takes to fit width.
+func printHexRows(width int, indent string, raw []byte) {
+	perLine := min(max((width-len(indent)+1)/3, 4), 32)
+	for line := range slices.Chunk(raw, perLine) {
+		var sb strings.Builder
+		sb.WriteString(indent)
+		for _, b := range line {
+			fmt.Fprintf(&sb, "%02x ", b)
+		}
+		fmt.Println(strings.TrimRight(sb.String(), " "))
+	}
+}
@@ -0,0 +1,59 @@
+package cfg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// makeBSA builds a TES3 BSA holding files, in order. Hashes are left zero.
+func makeBSA(names []string, contents [][]byte) []byte {
+	var pairs, nameOffsets, nameBlock, data bytes.Buffer
+	for i, name := range names {
+		binary.Write(&pairs, binary.LittleEndian, uint32(len(contents[i])))
+		binary.Write(&pairs, binary.LittleEndian, uint32(data.Len()))
+		binary.Write(&nameOffsets, binary.LittleEndian, uint32(nameBlock.Len()))
+		nameBlock.WriteString(name)
+		nameBlock.WriteByte(0)
+		data.Write(contents[i])
+	}
+	var out bytes.Buffer
+	binary.Write(&out, binary.LittleEndian, uint32(0x100))
+	binary.Write(&out, binary.LittleEndian, uint32(pairs.Len()+nameOffsets.Len()+nameBlock.Len()))
+	binary.Write(&out, binary.LittleEndian, uint32(len(names)))
+	out.Write(pairs.Bytes())
+	out.Write(nameOffsets.Bytes())
+	out.Write(nameBlock.Bytes())
+	out.Write(make([]byte, 8*len(names)))
+	out.Write(data.Bytes())
+	return out.Bytes()
+}
+
+func TestParseTES3Index(t *testing.T) {
+	raw := makeBSA(
+		[]string{`meshes\a.nif`, `textures\B.dds`},
+		[][]byte{[]byte("mesh"), []byte("texture")},
+	)
+	entries, err := parseTES3Index(bytes.NewReader(raw))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, "meshes/a.nif", entries[0].Name)
+	require.Equal(t, "textures/b.dds", entries[1].Name)
+	require.Equal(t, "texture", string(raw[entries[1].Offset:entries[1].Offset+entries[1].Size]))
+}
+
+func FuzzParseTES3Index(f *testing.F) {
+	f.Add(makeBSA([]string{`a.txt`}, [][]byte{[]byte("a")}))
+	f.Add(makeBSA([]string{`meshes\a.nif`, `textures\b.dds`}, [][]byte{[]byte("mesh"), {}}))
+	f.Fuzz(func(t *testing.T, raw []byte) {
+		entries, err := parseTES3Index(bytes.NewReader(raw))
+		if err != nil {
+			return
+		}
+		for _, e := range entries {
+			require.LessOrEqual(t, int(e.Offset)+int(e.Size), len(raw))
+		}
+	})
+}
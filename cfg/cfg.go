@@ -19,6 +19,9 @@ type Environment struct {
 	Data    []string
 	User    []string
 	Local   []string
+	// Encoding is the code page plugin strings are stored in, like "win1252".
+	// Empty if the cfg doesn't set it.
+	Encoding string
 
 	mux        sync.Mutex
 	bsaIndices map[string]([]*entry)
@@ -40,6 +40,9 @@ func Load(path string) (*Environment, error) {
 		out.Data = append(out.Data, ctx.dataDirs...)
 		out.User = append(out.User, ctx.userData...)
 		out.Local = append(out.Local, ctx.dataLocal...)
+		if ctx.encoding != "" {
+			out.Encoding = ctx.encoding
+		}
 	}
 
 	// Resolve plugin names to absolute paths by searching dataDirs in order
@@ -158,6 +161,7 @@ type configContext struct {
 	pluginNames   []string // store plugin *names* as declared (not resolved)
 	nestedConfigs []string
 	replaceConfig bool
+	encoding      string
 }
 
 // loadConfigRecursive recursively loads an openmw.cfg and any referenced sub-configs.
@@ -212,6 +216,9 @@ func loadConfigRecursive(cfgPath string, contexts *[]configContext, visited map[
 		case "user-data":
 			ctx.userData = append(ctx.userData, verifyPath(cfgPath, val))
 
+		case "encoding":
+			ctx.encoding = val
+
 		case "fallback-archive":
 			ctx.bsaArchives = append(ctx.bsaArchives, val)
 
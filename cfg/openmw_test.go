@@ -15,6 +15,7 @@ func TestOpenmwCFG(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, env.Plugins)
 	require.NotEmpty(t, env.Data)
+	require.Equal(t, "win1252", env.Encoding)
 }
 
 func TestRealOpenmwCFG(t *testing.T) {
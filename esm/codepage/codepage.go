@@ -0,0 +1,119 @@
+// Package codepage transcodes strings between UTF-8 and the legacy Windows
+// code pages Morrowind plugins are written in.
+//
+// The names match OpenMW's encoding= setting in openmw.cfg.
+package codepage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// ErrUnrepresentable is returned when a string contains a character the
+// code page can't encode.
+var ErrUnrepresentable = errors.New("character not representable")
+
+// Encoding is a single-byte code page whose lower half is ASCII.
+type Encoding struct {
+	name    string
+	high    *[128]rune
+	reverse map[rune]byte
+}
+
+func newEncoding(name string, high *[128]rune) *Encoding {
+	e := &Encoding{name: name, high: high, reverse: make(map[rune]byte, len(high))}
+	for i, r := range high {
+		e.reverse[r] = byte(0x80 + i)
+	}
+	return e
+}
+
+var (
+	// Win1250 is Central and Eastern European (Polish, Czech, Hungarian, ...).
+	Win1250 = newEncoding("win1250", &win1250High)
+	// Win1251 is Cyrillic (Russian, Ukrainian, ...).
+	Win1251 = newEncoding("win1251", &win1251High)
+	// Win1252 is Western European. This is OpenMW's default.
+	Win1252 = newEncoding("win1252", &win1252High)
+)
+
+// ByName finds the encoding with the OpenMW name, like "win1252".
+func ByName(name string) (*Encoding, error) {
+	for _, e := range []*Encoding{Win1250, Win1251, Win1252} {
+		if strings.EqualFold(strings.TrimSpace(name), e.name) {
+			return e, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown encoding %q", name)
+}
+
+// Name is the OpenMW name of the encoding.
+func (e *Encoding) Name() string { return e.name }
+
+// String implements fmt.Stringer.
+func (e *Encoding) String() string { return e.name }
+
+// Decode raw into a UTF-8 string. Every byte sequence is decodable.
+func (e *Encoding) Decode(raw []byte) string {
+	ascii := true
+	for _, b := range raw {
+		if b >= 0x80 {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return string(raw)
+	}
+	var sb strings.Builder
+	sb.Grow(len(raw) * 2)
+	for _, b := range raw {
+		if b < 0x80 {
+			sb.WriteByte(b)
+		} else {
+			sb.WriteRune(e.high[b-0x80])
+		}
+	}
+	return sb.String()
+}
+
+// Encode the UTF-8 string s into the code page.
+// Returns an error wrapping ErrUnrepresentable if any character in s
+// doesn't exist in the code page.
+func (e *Encoding) Encode(s string) ([]byte, error) {
+	out := make([]byte, 0, len(s))
+	for i, r := range s {
+		if r < 0x80 {
+			out = append(out, byte(r))
+			continue
+		}
+		b, ok := e.reverse[r]
+		if !ok {
+			return nil, fmt.Errorf("%q at byte %d in %s: %w", r, i, e.name, ErrUnrepresentable)
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+var current atomic.Pointer[Encoding]
+
+func init() {
+	current.Store(Win1252)
+}
+
+// Current is the encoding used to read and write plugin strings.
+// Defaults to Win1252.
+func Current() *Encoding {
+	return current.Load()
+}
+
+// Set the encoding used to read and write plugin strings.
+func Set(e *Encoding) {
+	if e == nil {
+		e = Win1252
+	}
+	current.Store(e)
+}
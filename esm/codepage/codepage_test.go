@@ -0,0 +1,60 @@
+package codepage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoundTripEveryByte(t *testing.T) {
+	raw := make([]byte, 256)
+	for i := range raw {
+		raw[i] = byte(i)
+	}
+	for _, e := range []*Encoding{Win1250, Win1251, Win1252} {
+		t.Run(e.Name(), func(t *testing.T) {
+			decoded := e.Decode(raw)
+			encoded, err := e.Encode(decoded)
+			require.NoError(t, err)
+			require.Equal(t, raw, encoded)
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	// "Привет" in win1251
+	require.Equal(t, "Привет", Win1251.Decode([]byte{0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2}))
+	// "Łódź" in win1250
+	require.Equal(t, "Łódź", Win1250.Decode([]byte{0xa3, 0xf3, 0x64, 0x9f}))
+	// "Café €5" in win1252
+	require.Equal(t, "Café €5", Win1252.Decode([]byte{'C', 'a', 'f', 0xe9, ' ', 0x80, '5'}))
+}
+
+func TestEncodeUnrepresentable(t *testing.T) {
+	_, err := Win1252.Encode("Привет")
+	require.ErrorIs(t, err, ErrUnrepresentable)
+
+	_, err = Win1251.Encode("Łódź")
+	require.ErrorIs(t, err, ErrUnrepresentable)
+
+	_, err = Win1252.Encode(string([]byte{0xff}))
+	require.ErrorIs(t, err, ErrUnrepresentable)
+}
+
+func TestByName(t *testing.T) {
+	e, err := ByName("WIN1251")
+	require.NoError(t, err)
+	require.Equal(t, Win1251, e)
+
+	_, err = ByName("utf8")
+	require.Error(t, err)
+}
+
+func TestSet(t *testing.T) {
+	defer Set(Win1252)
+	require.Equal(t, Win1252, Current())
+	Set(Win1250)
+	require.Equal(t, Win1250, Current())
+	Set(nil)
+	require.Equal(t, Win1252, Current())
+}
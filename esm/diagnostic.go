@@ -0,0 +1,89 @@
+package esm
+
+import "fmt"
+
+// Diagnostic describes malformed data that a lenient parser recovered from.
+type Diagnostic struct {
+	Plugin string
+	// Offset is the position in the plugin of the record or subrecord
+	// header the problem was found at.
+	Offset int64
+	Record RecordTag
+	// Subrecord is empty for problems with the record itself.
+	Subrecord SubrecordTag
+	Message   string
+}
+
+func (d Diagnostic) String() string {
+	where := string(d.Record)
+	if d.Subrecord != "" {
+		where += "." + string(d.Subrecord)
+	}
+	if where == "" {
+		return fmt.Sprintf("%s@0x%x: %s", d.Plugin, d.Offset, d.Message)
+	}
+	return fmt.Sprintf("%s@0x%x %s: %s", d.Plugin, d.Offset, where, d.Message)
+}
+
+// Sized is implemented by parsed subrecords with a fixed binary size.
+// Lenient parsing pads short ones with zeros and truncates long ones.
+type Sized interface {
+	Size() int
+}
+
+func (o *ParseOptions) report(d Diagnostic) {
+	if o.Report != nil {
+		o.Report(d)
+	}
+}
+
+// Reportf reports a Diagnostic about the i-th subrecord of rec, or about
+// rec itself if i is negative.
+func (o *ParseOptions) Reportf(rec *Record, i int, format string, args ...any) {
+	d := Diagnostic{
+		Plugin:  rec.PluginName,
+		Offset:  rec.PluginOffset,
+		Record:  rec.Tag,
+		Message: fmt.Sprintf(format, args...),
+	}
+	if i >= 0 {
+		d.Offset = SubrecordOffset(rec, i)
+		d.Subrecord = rec.Subrecords[i].Tag
+	}
+	o.report(d)
+}
+
+// SubrecordOffset is the position in the plugin of the header of the i-th
+// subrecord of rec, assuming rec was parsed from it unchanged.
+func SubrecordOffset(rec *Record, i int) int64 {
+	offset := rec.PluginOffset + 16
+	for _, sub := range rec.Subrecords[:i] {
+		offset += 8 + int64(len(sub.Data))
+	}
+	return offset
+}
+
+// Unmarshal the i-th subrecord of rec into p. Strict options return any
+// error. Lenient options resize Sized subrecords that fail to unmarshal;
+// if that doesn't help, the problem is reported and ok is false, so the
+// caller can keep the subrecord as unknown.
+func (o *ParseOptions) Unmarshal(rec *Record, i int, p ParsedSubrecord) (ok bool, err error) {
+	sub := rec.Subrecords[i]
+	err = sub.UnmarshalTo(p)
+	if err == nil {
+		return true, nil
+	}
+	if !o.Lenient {
+		return false, err
+	}
+	if s, isSized := p.(Sized); isSized && len(sub.Data) != s.Size() {
+		resized := make([]byte, s.Size())
+		copy(resized, sub.Data)
+		if (&Subrecord{Tag: sub.Tag, Data: resized}).UnmarshalTo(p) == nil {
+			o.Reportf(rec, i, "resized from %d to %d bytes", len(sub.Data), s.Size())
+			return true, nil
+		}
+	}
+	o.Reportf(rec, i, "%v; kept as unknown", err)
+	return false, nil
+}
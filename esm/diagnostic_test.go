@@ -0,0 +1,170 @@
+package esm_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/cell"
+	"github.com/ernmw/omwpacker/esm/record/tes3"
+	"github.com/stretchr/testify/require"
+)
+
+func writeRecords(t *testing.T, records ...*esm.Record) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, esm.WriteRecords(&buf, slices.Values(records)))
+	return buf.Bytes()
+}
+
+// parseBoth parses raw with ParsePluginData and OpenMapped, checks that
+// they agree, and returns the records and diagnostics.
+func parseBoth(t *testing.T, raw []byte, opts ...esm.ParseOption) ([]*esm.Record, []esm.Diagnostic, error) {
+	t.Helper()
+	var diagnostics []esm.Diagnostic
+	opts = append(opts, esm.WithDiagnostics(func(d esm.Diagnostic) { diagnostics = append(diagnostics, d) }))
+	records, err := esm.ParsePluginData("broken.esp", bytes.NewReader(raw), opts...)
+	fromReader := diagnostics
+
+	diagnostics = nil
+	path := filepath.Join(t.TempDir(), "broken.esp")
+	require.NoError(t, os.WriteFile(path, raw, 0644))
+	mapped, mappedErr := esm.OpenMapped(path, opts...)
+	require.Equal(t, err == nil, mappedErr == nil)
+	require.Equal(t, fromReader, diagnostics)
+	if mappedErr == nil {
+		defer mapped.Close()
+		require.Equal(t, records, mapped.Records)
+	}
+	return records, diagnostics, err
+}
+
+func TestLenientPluginData(t *testing.T) {
+	header, err := tes3.NewTES3Record("broken", "")
+	require.NoError(t, err)
+	valid := writeRecords(t, header, named("STAT", "rock"))
+
+	t.Run("offsets", func(t *testing.T) {
+		records, diagnostics, err := parseBoth(t, valid)
+		require.NoError(t, err)
+		require.Empty(t, diagnostics)
+		require.Equal(t, int64(0), records[0].PluginOffset)
+		require.Equal(t, int64(len(writeRecords(t, header))), records[1].PluginOffset)
+	})
+
+	for name, tc := range map[string]struct {
+		raw     []byte
+		records int
+		message string
+	}{
+		"short junk": {
+			raw:     append(slices.Clone(valid), "junk"...),
+			records: 2,
+			message: "ignored 4 bytes of junk after the last record",
+		},
+		"header sized junk": {
+			raw:     append(slices.Clone(valid), "this is no recor"...),
+			records: 2,
+			message: `ignored 16 bytes of junk after the last record, starting "this"`,
+		},
+		"long junk": {
+			raw:     append(slices.Clone(valid), "this is not a record at all"...),
+			records: 2,
+			message: `stopped parsing at a corrupt record header starting "this"; discarded the remaining 27 bytes`,
+		},
+		"records after a corrupt header": {
+			raw:     slices.Concat(valid, []byte("\x00\x01\x02\x03\xff\xff\xff\xff"), make([]byte, 8), valid),
+			records: 2,
+			message: fmt.Sprintf(`stopped parsing at a corrupt record header starting "\x00\x01\x02\x03"; discarded the remaining %d bytes`, 16+len(valid)),
+		},
+		"truncated record": {
+			raw:     valid[:len(valid)-2],
+			records: 1,
+			message: "dropped record truncated before its 13 byte body ended",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := parseBoth(t, tc.raw)
+			require.Error(t, err)
+
+			records, diagnostics, err := parseBoth(t, tc.raw, esm.WithLenient())
+			require.NoError(t, err)
+			require.Len(t, records, tc.records)
+			require.Len(t, diagnostics, 1)
+			require.Equal(t, tc.message, diagnostics[0].Message)
+			require.Equal(t, int64(len(writeRecords(t, records...))), diagnostics[0].Offset)
+		})
+	}
+
+	t.Run("corrupt subrecord", func(t *testing.T) {
+		raw := writeRecords(t, header, &esm.Record{Tag: "STAT", Subrecords: []*esm.Subrecord{
+			{Tag: esm.NAME, Data: []byte("rock\x00")},
+			{Tag: "MODL", Data: []byte("rock.nif\x00")},
+		}})
+		// Claim MODL is longer than the record.
+		raw[len(raw)-9-4] = 0xff
+		_, _, err := parseBoth(t, raw)
+		require.Error(t, err)
+
+		records, diagnostics, err := parseBoth(t, raw, esm.WithLenient())
+		require.NoError(t, err)
+		require.Len(t, records[1].Subrecords, 1)
+		require.Len(t, diagnostics, 1)
+		require.Equal(t, esm.SubrecordTag("MODL"), diagnostics[0].Subrecord)
+		require.Equal(t, esm.SubrecordOffset(records[1], 1), diagnostics[0].Offset)
+	})
+}
+
+func TestLenientTyped(t *testing.T) {
+	rec := &esm.Record{
+		Tag:          cell.CELL,
+		PluginName:   "broken.esp",
+		PluginOffset: 100,
+		Subrecords: []*esm.Subrecord{
+			{Tag: cell.NAME, Data: []byte("no terminator")},
+			{Tag: cell.DATA, Data: []byte{1, 0, 0, 0, 0, 0, 0, 0}},
+		},
+	}
+	_, err := esm.Decode(rec)
+	require.Error(t, err)
+
+	var diagnostics []esm.Diagnostic
+	parsed, err := esm.Decode(rec, esm.WithLenient(), esm.WithDiagnostics(func(d esm.Diagnostic) {
+		diagnostics = append(diagnostics, d)
+	}))
+	require.NoError(t, err)
+	c := parsed.(*cell.CellRecord)
+	require.Nil(t, c.NAME)
+	require.Equal(t, []esm.UnknownSubrecord{{Index: 0, Sub: rec.Subrecords[0]}}, c.Unknown)
+	require.Equal(t, &cell.DATAField{Flags: cell.InteriorFlag}, c.DATA)
+
+	require.Len(t, diagnostics, 3)
+	require.Equal(t, int64(116), diagnostics[0].Offset)
+	require.Equal(t, cell.NAME, diagnostics[0].Subrecord)
+	require.Equal(t, int64(116+8+13), diagnostics[1].Offset)
+	require.Equal(t, "broken.esp@0x89 CELL.DATA: resized from 8 to 12 bytes", diagnostics[1].String())
+	require.Equal(t, "broken.esp@0x64 CELL: missing required NAME", diagnostics[2].String())
+
+	encoded, err := esm.Encode(c)
+	require.NoError(t, err)
+	require.Equal(t, rec.Subrecords[0], encoded.Subrecords[0])
+
+	t.Run("short header", func(t *testing.T) {
+		header, err := tes3.NewTES3Record("short", "")
+		require.NoError(t, err)
+		header.Subrecords[0].Data = header.Subrecords[0].Data[:290]
+		strict := esm.NewParseOptions()
+		_, err = strict.Unmarshal(header, 0, &tes3.HEDRdata{})
+		require.Error(t, err)
+
+		hedr := &tes3.HEDRdata{}
+		ok, err := esm.NewParseOptions(esm.WithLenient()).Unmarshal(header, 0, hedr)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, "short", hedr.Name)
+	})
+}
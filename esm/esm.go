@@ -5,6 +5,7 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
 	"iter"
@@ -18,8 +19,8 @@ import (
 type RecordTag string
 type SubrecordTag string
 
-var ErrArgumentNil error
-var ErrTagMismatch error
+var ErrArgumentNil = errors.New("argument is nil")
+var ErrTagMismatch = errors.New("tag mismatch")
 
 func newErrTagMismatch(expected SubrecordTag, got SubrecordTag) error {
 	if expected != got {
@@ -86,6 +87,16 @@ type Record struct {
 	PluginOffset int64
 }
 
+// Clone returns a deep copy of the record that shares no memory with it.
+func (r *Record) Clone() *Record {
+	out := *r
+	out.Subrecords = make([]*Subrecord, len(r.Subrecords))
+	for i, sub := range r.Subrecords {
+		out.Subrecords[i] = &Subrecord{Tag: sub.Tag, Data: bytes.Clone(sub.Data)}
+	}
+	return &out
+}
+
 var padding = []byte{0, 0, 0, 0}
 
 // Write the record to the writer w.
@@ -133,61 +144,173 @@ func readUint32LE(b []byte) uint32 {
 	return binary.LittleEndian.Uint32(b)
 }
 
-func readNextRecord(headerBuffer []byte, pluginName string, br io.Reader) (*Record, error) {
+// smallRecordSize is the largest record body allocated up front. Bigger
+// bodies grow as data arrives, so a lying size field in a short file can't
+// force a huge allocation.
+const smallRecordSize = 1 << 20
+
+func readBody(br io.Reader, size uint32) ([]byte, error) {
+	if size <= smallRecordSize {
+		body := make([]byte, size)
+		if _, err := io.ReadFull(br, body); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return nil, err
+		}
+		return body, nil
+	}
+	var buff bytes.Buffer
+	n, err := buff.ReadFrom(io.LimitReader(br, int64(size)))
+	if err != nil {
+		return nil, err
+	}
+	if n != int64(size) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	return buff.Bytes(), nil
+}
+
+// readNextRecord reads the record at offset. It returns nil at the end of
+// the plugin, or where a lenient parser gives up on junk or a corrupt
+// header.
+func readNextRecord(headerBuffer []byte, pluginName string, offset int64, br io.Reader, opts *ParseOptions) (*Record, uint32, error) {
 	n, err := io.ReadFull(br, headerBuffer)
 	if err == io.EOF || (err == io.ErrUnexpectedEOF && n == 0) {
-		return nil, nil
+		return nil, 0, nil
+	}
+	if err == io.ErrUnexpectedEOF && opts.Lenient {
+		opts.report(Diagnostic{Plugin: pluginName, Offset: offset, Message: fmt.Sprintf("ignored %d bytes of junk after the last record", n)})
+		return nil, 0, nil
 	}
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	tag := RecordTag(string(headerBuffer[0:4]))
-	size := readUint32LE(headerBuffer[4:8])
-	flags := readUint32LE(headerBuffer[12:16])
+	rec, size, err := parseRecordHeader(headerBuffer, pluginName, offset, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	if rec == nil {
+		rest, err := io.Copy(io.Discard, br)
+		if err != nil {
+			return nil, 0, err
+		}
+		reportCorruptHeader(opts, pluginName, offset, headerBuffer, int64(len(headerBuffer))+rest)
+		return nil, 0, nil
+	}
+	body, err := readBody(br, size)
+	if err != nil {
+		if opts.Lenient && errors.Is(err, io.ErrUnexpectedEOF) {
+			opts.report(Diagnostic{Plugin: pluginName, Offset: offset, Record: rec.Tag, Message: fmt.Sprintf("dropped record truncated before its %d byte body ended", size)})
+			return nil, 0, nil
+		}
+		return nil, 0, fmt.Errorf("record %q: %w", rec.Tag, err)
+	}
+	if err := parseSubrecords(rec, body, opts); err != nil {
+		return nil, 0, err
+	}
+	return rec, size, nil
+}
+
+// parseRecordHeader returns a Record with no subrecords for the 16 byte
+// header hdr, and the size of its body. It returns a nil Record where a
+// lenient parser gives up on a header with an invalid tag; the caller
+// reports it with reportCorruptHeader.
+func parseRecordHeader(hdr []byte, pluginName string, offset int64, opts *ParseOptions) (*Record, uint32, error) {
+	tag := RecordTag(string(hdr[0:4]))
+	size := readUint32LE(hdr[4:8])
+	flags := readUint32LE(hdr[12:16])
 
-	body := make([]byte, size)
-	if _, err := io.ReadFull(br, body); err != nil {
-		return nil, fmt.Errorf("record %q: %w", tag, err)
+	if opts.Lenient && !validTag(hdr[0:4]) {
+		return nil, 0, nil
 	}
+	if size > opts.MaxRecordSize {
+		return nil, 0, fmt.Errorf("record %q size %d exceeds %d: %w", tag, size, opts.MaxRecordSize, ErrLimitExceeded)
+	}
+	return &Record{
+		Tag:          tag,
+		Flags:        flags,
+		PluginName:   pluginName,
+		PluginOffset: offset,
+		Subrecords:   []*Subrecord{},
+	}, size, nil
+}
 
-	rec := &Record{
-		Tag:        tag,
-		Flags:      flags,
-		PluginName: pluginName,
-		Subrecords: []*Subrecord{},
-		// PluginOffset can be tracked externally if needed
+// reportCorruptHeader reports that a lenient parser stopped at the header
+// hdr at offset, discarding it and the rest of the plugin, discarded bytes
+// in all. A header that ends the plugin is junk after the last record;
+// anything after it may have held more records.
+func reportCorruptHeader(opts *ParseOptions, pluginName string, offset int64, hdr []byte, discarded int64) {
+	message := fmt.Sprintf("ignored %d bytes of junk after the last record, starting %q", discarded, hdr[0:4])
+	if discarded > int64(len(hdr)) {
+		message = fmt.Sprintf("stopped parsing at a corrupt record header starting %q; discarded the remaining %d bytes", hdr[0:4], discarded)
 	}
+	opts.report(Diagnostic{Plugin: pluginName, Offset: offset, Message: message})
+}
 
-	// Parse the body buffer without more I/O
-	pos := 0
-	for pos < int(size) {
-		if pos+8 > int(size) {
-			return nil, fmt.Errorf("corrupt subrecord header in %q", tag)
+// validTag reports whether tag looks like a record tag: upper case
+// letters, digits and underscores.
+func validTag(tag []byte) bool {
+	for _, c := range tag {
+		if !('A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_') {
+			return false
 		}
+	}
+	return true
+}
 
-		subtag := SubrecordTag(string(body[pos : pos+4]))
-		subsize := readUint32LE(body[pos+4 : pos+8])
-		pos += 8
-
-		if pos+int(subsize) > int(size) {
-			return nil, fmt.Errorf("corrupt subrecord %q in %q", subtag, tag)
+// parseSubrecords splits a record body into rec's subrecords without
+// copying. Each Data has its capacity clipped, so appending to it can't
+// overwrite the next subrecord. Lenient options drop a corrupt subrecord
+// and everything after it in the body.
+func parseSubrecords(rec *Record, body []byte, opts *ParseOptions) error {
+	size := len(body)
+	pos := 0
+	for pos < size {
+		var problem string
+		var subtag SubrecordTag
+		var subsize uint32
+		if pos+8 > size {
+			problem = fmt.Sprintf("corrupt subrecord header in %q", rec.Tag)
+		} else {
+			subtag = SubrecordTag(string(body[pos : pos+4]))
+			subsize = readUint32LE(body[pos+4 : pos+8])
+			if uint64(pos)+8+uint64(subsize) > uint64(size) {
+				problem = fmt.Sprintf("corrupt subrecord %q in %q", subtag, rec.Tag)
+			}
+		}
+		if problem != "" {
+			if !opts.Lenient {
+				return errors.New(problem)
+			}
+			opts.report(Diagnostic{
+				Plugin:    rec.PluginName,
+				Offset:    rec.PluginOffset + 16 + int64(pos),
+				Record:    rec.Tag,
+				Subrecord: subtag,
+				Message:   fmt.Sprintf("%s; dropped the last %d bytes of the record", problem, size-pos),
+			})
+			return nil
+		}
+		if len(rec.Subrecords) >= opts.MaxSubrecords {
+			return fmt.Errorf("record %q has more than %d subrecords: %w", rec.Tag, opts.MaxSubrecords, ErrLimitExceeded)
 		}
 
-		sr := &Subrecord{
+		pos += 8
+		end := pos + int(subsize)
+		rec.Subrecords = append(rec.Subrecords, &Subrecord{
 			Tag:  subtag,
-			Data: body[pos : pos+int(subsize)],
-		}
-		rec.Subrecords = append(rec.Subrecords, sr)
-		pos += int(subsize)
+			Data: body[pos:end:end],
+		})
+		pos = end
 	}
-
-	return rec, nil
+	return nil
 }
 
 // ParsePluginFile extracts records from some esm or omwaddon file.
 // See https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format
-func ParsePluginFile(path string) ([]*Record, error) {
+func ParsePluginFile(path string, opts ...ParseOption) ([]*Record, error) {
 	pluginName := strings.ToLower(filepath.Base(path))
 	f, err := os.Open(path)
 	if err != nil {
@@ -197,15 +320,17 @@ func ParsePluginFile(path string) ([]*Record, error) {
 
 	bufferedFile := bufio.NewReader(f)
 
-	return ParsePluginData(pluginName, bufferedFile)
+	return ParsePluginData(pluginName, bufferedFile, opts...)
 }
 
 // ParsePluginData extracts records from an io.Reader.
-func ParsePluginData(pluginName string, f io.Reader) ([]*Record, error) {
+func ParsePluginData(pluginName string, f io.Reader, opts ...ParseOption) ([]*Record, error) {
+	o := NewParseOptions(opts...)
 	records := []*Record{}
 	hdr := make([]byte, 16)
+	var offset int64
 	for {
-		rec, err := readNextRecord(hdr, pluginName, f)
+		rec, size, err := readNextRecord(hdr, pluginName, offset, f, o)
 		if err != nil {
 			return nil, err
 		}
@@ -213,6 +338,7 @@ func ParsePluginData(pluginName string, f io.Reader) ([]*Record, error) {
 			break
 		}
 		records = append(records, rec)
+		offset += 16 + int64(size)
 	}
 	return records, nil
 }
@@ -0,0 +1,101 @@
+package esm
+
+import "fmt"
+
+// FieldParser unmarshals the subrecords of a record part into typed
+// fields. Generated record parsers use it.
+type FieldParser struct {
+	Rec  *Record
+	Opts *ParseOptions
+	// Base is the index in Rec of the first subrecord of the part being
+	// parsed. Unknown indices are relative to it.
+	Base    int
+	Unknown *[]UnknownSubrecord
+}
+
+// Keep the i-th subrecord of Rec as unknown.
+func (f *FieldParser) Keep(i int) {
+	*f.Unknown = append(*f.Unknown, UnknownSubrecord{Index: i - f.Base, Sub: f.Rec.Subrecords[i]})
+}
+
+// Missing handles a required field with the given tag that wasn't found
+// in the part starting at Base. Strict options return an error; lenient
+// ones report it.
+func (f *FieldParser) Missing(tag SubrecordTag) error {
+	if !f.Opts.Lenient {
+		return fmt.Errorf("missing required %s", tag)
+	}
+	at := f.Base
+	if at == 0 || at >= len(f.Rec.Subrecords) {
+		at = -1
+	}
+	f.Opts.Reportf(f.Rec, at, "missing required %s", tag)
+	return nil
+}
+
+// ParseField unmarshals the i-th subrecord of f.Rec into a new T. If
+// lenient options can't repair it, it is kept as unknown and nil is
+// returned.
+func ParseField[T any, P interface {
+	*T
+	ParsedSubrecord
+}](f *FieldParser, i int) (P, error) {
+	p := P(new(T))
+	ok, err := f.Opts.Unmarshal(f.Rec, i, p)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		f.Keep(i)
+		return nil, nil
+	}
+	return p, nil
+}
+
+// MatchField returns the index in fields of the field that a subrecord
+// with the given tag belongs to, or -1. fields lists the tag each field
+// starts with, in order; several fields may share a tag. The first match
+// at or after cursor, the index of the last field matched, wins; failing
+// that, the first match before it.
+func MatchField(fields []SubrecordTag, cursor int, tag SubrecordTag) int {
+	for i := max(cursor, 0); i < len(fields); i++ {
+		if fields[i] == tag {
+			return i
+		}
+	}
+	for i := 0; i < min(cursor, len(fields)); i++ {
+		if fields[i] == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+// AppendMarshalled appends p, marshalled, to subs. Nil fields are skipped.
+func AppendMarshalled(subs []*Subrecord, p ParsedSubrecord) ([]*Subrecord, error) {
+	sub, err := p.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("marshal %q: %w", p.Tag(), err)
+	}
+	if sub == nil {
+		return subs, nil
+	}
+	return append(subs, sub), nil
+}
+
+// Ordered is a record or a part of one that marshals to several
+// subrecords.
+type Ordered interface {
+	// OrderedRecords marshals the receiver into subrecords, in order.
+	// If the receiver is nil, this should return (nil, nil).
+	OrderedRecords() ([]*Subrecord, error)
+}
+
+// AppendOrdered appends the subrecords of p to subs.
+func AppendOrdered(subs []*Subrecord, p Ordered) ([]*Subrecord, error) {
+	more, err := p.OrderedRecords()
+	if err != nil {
+		return nil, err
+	}
+	return append(subs, more...), nil
+}
@@ -0,0 +1,288 @@
+package esm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// ErrSubrecordNotFound is returned when a record lacks a required subrecord.
+var ErrSubrecordNotFound = errors.New("subrecord not found")
+
+// INFO records are dialogue responses. They have no identity of their own;
+// each belongs to the DIAL record preceding it in the plugin.
+const (
+	DIAL RecordTag = "DIAL"
+	INFO RecordTag = "INFO"
+)
+
+// NAME holds the ID of most records.
+const NAME SubrecordTag = "NAME"
+
+// INAM holds the ID of an INFO record within its dialogue.
+const INAM SubrecordTag = "INAM"
+
+// DELE marks a record as deleted: a plugin that loads later removes the
+// object an earlier one added. Typed records keep it with their unknown
+// subrecords.
+const DELE SubrecordTag = "DELE"
+
+// ID identifies a record. Records with equal IDs describe the same game
+// object; a later plugin in the load order replaces an earlier one's.
+type ID struct {
+	Tag RecordTag
+	// Parent is the Key of the record this one belongs to, if any.
+	// INFO records belong to the preceding DIAL.
+	Parent string
+	// Key is the folded object ID, grid or index, depending on Tag.
+	// Singleton records have an empty Key.
+	Key string
+}
+
+func (id ID) String() string {
+	if id.Parent != "" {
+		return fmt.Sprintf("%s[%s/%s]", id.Tag, id.Parent, id.Key)
+	}
+	return fmt.Sprintf("%s[%s]", id.Tag, id.Key)
+}
+
+// FoldID folds an object ID for comparison. Like the engine, only ASCII
+// letters are case-folded.
+func FoldID(id string) string {
+	return strings.Map(func(r rune) rune {
+		if 'A' <= r && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return r
+	}, id)
+}
+
+// GridKey is the Key of a record identified by exterior cell coordinates.
+func GridKey(x, y int32) string {
+	return fmt.Sprintf("%d,%d", x, y)
+}
+
+// IdentityFunc returns the Key of rec. It need not fold case; Identify
+// does that.
+type IdentityFunc func(rec *Record) (string, error)
+
+var identities = struct {
+	mux   sync.RWMutex
+	funcs map[RecordTag]IdentityFunc
+}{funcs: map[RecordTag]IdentityFunc{}}
+
+// RegisterIdentity makes fn the identity function for records with the
+// given tag. Records without one are identified by their NAME subrecord.
+// Record packages call this from init.
+func RegisterIdentity(tag RecordTag, fn IdentityFunc) {
+	if fn == nil {
+		panic(fmt.Sprintf("esm: nil identity for %q", tag))
+	}
+	identities.mux.Lock()
+	defer identities.mux.Unlock()
+	if _, ok := identities.funcs[tag]; ok {
+		panic(fmt.Sprintf("esm: identity for %q registered twice", tag))
+	}
+	identities.funcs[tag] = fn
+}
+
+// Singleton is the IdentityFunc of records that appear at most once per
+// plugin.
+func Singleton(*Record) (string, error) { return "", nil }
+
+// NameIdentity is the default IdentityFunc. It returns the NAME subrecord.
+func NameIdentity(rec *Record) (string, error) {
+	return stringSubrecord(rec, NAME)
+}
+
+func stringSubrecord(rec *Record, tag SubrecordTag) (string, error) {
+	sub := rec.Find(tag)
+	if sub == nil {
+		return "", fmt.Errorf("%s: %w", tag, ErrSubrecordNotFound)
+	}
+	return util.ReadPaddedString(sub.Data), nil
+}
+
+// Find returns the first subrecord with the given tag, or nil.
+func (r *Record) Find(tag SubrecordTag) *Subrecord {
+	for _, sub := range r.Subrecords {
+		if sub.Tag == tag {
+			return sub
+		}
+	}
+	return nil
+}
+
+// Deleted reports whether r deletes its object.
+func (r *Record) Deleted() bool {
+	return r.Find(DELE) != nil
+}
+
+// UnmarshalFirst unmarshals the first subrecord with p's tag into p.
+func (r *Record) UnmarshalFirst(p ParsedSubrecord) error {
+	sub := r.Find(p.Tag())
+	if sub == nil {
+		return fmt.Errorf("%s: %w", p.Tag(), ErrSubrecordNotFound)
+	}
+	return sub.UnmarshalTo(p)
+}
+
+// Identifier assigns IDs to the records of a plugin, in order.
+// It remembers the last DIAL so that following INFO records can be
+// identified. The zero value is ready to use.
+type Identifier struct {
+	dialogue    string
+	hasDialogue bool
+}
+
+// Identify returns the ID of rec, which must come after every record
+// previously passed to this Identifier in its plugin.
+func (i *Identifier) Identify(rec *Record) (ID, error) {
+	if rec == nil {
+		return ID{}, ErrArgumentNil
+	}
+	if rec.Tag == INFO {
+		if !i.hasDialogue {
+			return ID{}, fmt.Errorf("%s record before any %s", INFO, DIAL)
+		}
+		key, err := stringSubrecord(rec, INAM)
+		if err != nil {
+			return ID{}, fmt.Errorf("identify %s: %w", rec.Tag, err)
+		}
+		return ID{Tag: INFO, Parent: i.dialogue, Key: FoldID(key)}, nil
+	}
+
+	identities.mux.RLock()
+	fn, ok := identities.funcs[rec.Tag]
+	identities.mux.RUnlock()
+	if !ok {
+		fn = NameIdentity
+	}
+	key, err := fn(rec)
+	if err != nil {
+		return ID{}, fmt.Errorf("identify %s: %w", rec.Tag, err)
+	}
+	id := ID{Tag: rec.Tag, Key: FoldID(key)}
+	if rec.Tag == DIAL {
+		i.dialogue, i.hasDialogue = id.Key, true
+	}
+	return id, nil
+}
+
+// PluginIndex maps IDs to the records of one plugin.
+type PluginIndex struct {
+	Plugin  string
+	ids     []ID
+	records map[ID]*Record
+}
+
+// NewPluginIndex indexes records, which must be in plugin order.
+// Records that can't be identified are reported in the joined error and
+// left out of the index. As in the engine, if an ID repeats, the later
+// record replaces the earlier one.
+func NewPluginIndex(plugin string, records []*Record) (*PluginIndex, error) {
+	idx := &PluginIndex{Plugin: plugin, records: map[ID]*Record{}}
+	var identifier Identifier
+	var errs []error
+	for i, rec := range records {
+		id, err := identifier.Identify(rec)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: record %d: %w", plugin, i, err))
+			continue
+		}
+		if _, ok := idx.records[id]; !ok {
+			idx.ids = append(idx.ids, id)
+		}
+		idx.records[id] = rec
+	}
+	return idx, errors.Join(errs...)
+}
+
+// Get returns the record with the given ID.
+func (p *PluginIndex) Get(id ID) (*Record, bool) {
+	rec, ok := p.records[id]
+	return rec, ok
+}
+
+// IDs lists the indexed IDs in plugin order.
+func (p *PluginIndex) IDs() []ID {
+	return p.ids
+}
+
+// Len is the number of indexed records.
+func (p *PluginIndex) Len() int {
+	return len(p.ids)
+}
+
+// LoadOrderIndex maps IDs to records across a load order, where later
+// plugins override earlier ones.
+type LoadOrderIndex struct {
+	plugins []*PluginIndex
+	ids     []ID
+	records map[ID][]*Record
+}
+
+// NewLoadOrderIndex combines plugin indices, given in load order.
+func NewLoadOrderIndex(plugins ...*PluginIndex) *LoadOrderIndex {
+	idx := &LoadOrderIndex{plugins: plugins, records: map[ID][]*Record{}}
+	for _, p := range plugins {
+		for _, id := range p.ids {
+			if _, ok := idx.records[id]; !ok {
+				idx.ids = append(idx.ids, id)
+			}
+			idx.records[id] = append(idx.records[id], p.records[id])
+		}
+	}
+	return idx
+}
+
+// Plugins returns the indexed plugins in load order.
+func (l *LoadOrderIndex) Plugins() []*PluginIndex {
+	return l.plugins
+}
+
+// Get returns the winning record with the given ID: the one from the
+// latest plugin that defines it.
+func (l *LoadOrderIndex) Get(id ID) (*Record, bool) {
+	recs := l.records[id]
+	if len(recs) == 0 {
+		return nil, false
+	}
+	return recs[len(recs)-1], true
+}
+
+// Find returns the winning record whose key is key, trying tags in
+// order. It is for IDs that don't say what kind of record they name,
+// such as the base object of a cell reference.
+func (l *LoadOrderIndex) Find(key string, tags ...RecordTag) (*Record, bool) {
+	for _, tag := range tags {
+		if rec, ok := l.Get(ID{Tag: tag, Key: FoldID(key)}); ok {
+			return rec, true
+		}
+	}
+	return nil, false
+}
+
+// Providers returns every record with the given ID, in load order.
+func (l *LoadOrderIndex) Providers(id ID) []*Record {
+	return l.records[id]
+}
+
+// IDs lists every ID in the load order, in order of first appearance.
+func (l *LoadOrderIndex) IDs() []ID {
+	return l.ids
+}
+
+// Conflicts lists the IDs defined by more than one plugin.
+func (l *LoadOrderIndex) Conflicts() []ID {
+	out := []ID{}
+	for _, id := range l.ids {
+		if len(l.records[id]) > 1 {
+			out = append(out, id)
+		}
+	}
+	return out
+}
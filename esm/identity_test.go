@@ -0,0 +1,162 @@
+package esm_test
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/acti"
+	"github.com/ernmw/omwpacker/esm/record/alch"
+	"github.com/ernmw/omwpacker/esm/record/appa"
+	"github.com/ernmw/omwpacker/esm/record/armo"
+	"github.com/ernmw/omwpacker/esm/record/book"
+	"github.com/ernmw/omwpacker/esm/record/cell"
+	"github.com/ernmw/omwpacker/esm/record/clot"
+	"github.com/ernmw/omwpacker/esm/record/cont"
+	"github.com/ernmw/omwpacker/esm/record/crea"
+	"github.com/ernmw/omwpacker/esm/record/dialogue"
+	"github.com/ernmw/omwpacker/esm/record/door"
+	"github.com/ernmw/omwpacker/esm/record/ench"
+	"github.com/ernmw/omwpacker/esm/record/glob"
+	"github.com/ernmw/omwpacker/esm/record/gmst"
+	"github.com/ernmw/omwpacker/esm/record/ingr"
+	"github.com/ernmw/omwpacker/esm/record/land"
+	"github.com/ernmw/omwpacker/esm/record/levc"
+	"github.com/ernmw/omwpacker/esm/record/levi"
+	"github.com/ernmw/omwpacker/esm/record/ligh"
+	"github.com/ernmw/omwpacker/esm/record/lock"
+	"github.com/ernmw/omwpacker/esm/record/ltex"
+	"github.com/ernmw/omwpacker/esm/record/mgef"
+	"github.com/ernmw/omwpacker/esm/record/misc"
+	"github.com/ernmw/omwpacker/esm/record/npc"
+	"github.com/ernmw/omwpacker/esm/record/pathgrid"
+	"github.com/ernmw/omwpacker/esm/record/prob"
+	"github.com/ernmw/omwpacker/esm/record/repa"
+	"github.com/ernmw/omwpacker/esm/record/script"
+	"github.com/ernmw/omwpacker/esm/record/spel"
+	"github.com/ernmw/omwpacker/esm/record/stat"
+	"github.com/ernmw/omwpacker/esm/record/weap"
+	"github.com/stretchr/testify/require"
+)
+
+func named(tag esm.RecordTag, name string) *esm.Record {
+	return &esm.Record{Tag: tag, Subrecords: []*esm.Subrecord{{Tag: esm.NAME, Data: []byte(name + "\x00")}}}
+}
+
+func info(inam string) *esm.Record {
+	return &esm.Record{Tag: esm.INFO, Subrecords: []*esm.Subrecord{{Tag: esm.INAM, Data: []byte(inam + "\x00")}}}
+}
+
+func TestIdentify(t *testing.T) {
+	records, _ := synthesizePlugin(t)
+	var identifier esm.Identifier
+	ids := []esm.ID{}
+	for _, rec := range records {
+		id, err := identifier.Identify(rec)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	require.Equal(t, []esm.ID{
+		{Tag: "TES3"},
+		{Tag: cell.CELL, Key: "balmora, caius cosades' house"},
+		{Tag: cell.CELL, Key: "-3,4"},
+		{Tag: npc.NPC_, Key: "hlaalu guard"},
+		{Tag: npc.NPC_, Key: "fargoth"},
+		{Tag: crea.CREA, Key: "skeleton archer"},
+		{Tag: weap.WEAP, Key: "iron longsword"},
+		{Tag: armo.ARMO, Key: "iron_cuirass"},
+		{Tag: clot.CLOT, Key: "common_ring_01"},
+		{Tag: book.BOOK, Key: "bookskill_alchemy1"},
+		{Tag: misc.MISC, Key: "key_caius_cosades"},
+		{Tag: alch.ALCH, Key: "p_restore_health_s"},
+		{Tag: ingr.INGR, Key: "ingred_crab_meat_01"},
+		{Tag: appa.APPA, Key: "apparatus_a_mortar_01"},
+		{Tag: lock.LOCK, Key: "pick_apprentice_01"},
+		{Tag: prob.PROB, Key: "probe_bent"},
+		{Tag: repa.REPA, Key: "hammer_repair"},
+		{Tag: acti.ACTI, Key: "active_sign_balmora"},
+		{Tag: cont.CONT, Key: "chest_small_01"},
+		{Tag: door.DOOR, Key: "ex_common_door_01"},
+		{Tag: ligh.LIGH, Key: "light_com_candle_01"},
+		{Tag: stat.STAT, Key: "furn_de_chair_01"},
+		{Tag: spel.SPEL, Key: "fireball"},
+		{Tag: ench.ENCH, Key: "ring_en"},
+		{Tag: mgef.MGEF, Key: "14"},
+		{Tag: dialogue.DIAL, Key: "little secret"},
+		{Tag: dialogue.INFO, Parent: "little secret", Key: "2130720681233926400"},
+		{Tag: dialogue.INFO, Parent: "little secret", Key: "1527513162285425046"},
+		{Tag: script.SCPT, Key: "doorscript"},
+		{Tag: gmst.GMST, Key: "syes"},
+		{Tag: gmst.GMST, Key: "imaxactivatedist"},
+		{Tag: gmst.GMST, Key: "fjumpmovebase"},
+		{Tag: glob.GLOB, Key: "gamehour"},
+		{Tag: levi.LEVI, Key: "random_iron_weapon"},
+		{Tag: levc.LEVC, Key: "in_tomb_all_lev+0"},
+		{Tag: pathgrid.PGRD, Key: "-3,4"},
+		{Tag: land.LAND, Key: "-3,4"},
+		{Tag: ltex.LTEX, Key: "12"},
+		{Tag: "LUAL"},
+	}, ids)
+
+	t.Run("info follows dialogue", func(t *testing.T) {
+		var identifier esm.Identifier
+		_, err := identifier.Identify(info("1"))
+		require.Error(t, err)
+
+		for _, rec := range []*esm.Record{named(esm.DIAL, "Background"), info("12345")} {
+			id, err := identifier.Identify(rec)
+			require.NoError(t, err)
+			if rec.Tag == esm.INFO {
+				require.Equal(t, esm.ID{Tag: esm.INFO, Parent: "background", Key: "12345"}, id)
+			}
+		}
+	})
+
+	t.Run("only ASCII is folded", func(t *testing.T) {
+		require.Equal(t, "ÉbÈne_dagger", esm.FoldID("ÉBÈNE_Dagger"))
+	})
+}
+
+func TestLoadOrderIndex(t *testing.T) {
+	base, err := esm.NewPluginIndex("base.esm", []*esm.Record{
+		named("STAT", "Rock_01"),
+		named("DIAL", "Greeting 0"),
+		info("1"),
+		named("STAT", "tree_01"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, base.Len())
+
+	patch, err := esm.NewPluginIndex("patch.esp", []*esm.Record{
+		named("STAT", "ROCK_01"),
+		{Tag: "STAT"},
+		named("DIAL", "greeting 0"),
+		info("1"),
+		info("2"),
+	})
+	require.ErrorIs(t, err, esm.ErrSubrecordNotFound)
+	require.Equal(t, 4, patch.Len())
+
+	lo := esm.NewLoadOrderIndex(base, patch)
+	rock := esm.ID{Tag: "STAT", Key: "rock_01"}
+	winner, ok := lo.Get(rock)
+	require.True(t, ok)
+	require.Equal(t, "ROCK_01\x00", string(winner.Find(esm.NAME).Data))
+	require.Len(t, lo.Providers(rock), 2)
+	require.Equal(t, []esm.ID{
+		rock,
+		{Tag: "DIAL", Key: "greeting 0"},
+		{Tag: esm.INFO, Parent: "greeting 0", Key: "1"},
+	}, lo.Conflicts())
+	require.Len(t, lo.IDs(), 5)
+
+	_, ok = lo.Get(esm.ID{Tag: "STAT", Key: "missing"})
+	require.False(t, ok)
+}
+
+func TestIndexTestdata(t *testing.T) {
+	records, err := esm.ParsePluginFile("testdata/large.esp")
+	require.NoError(t, err)
+	idx, err := esm.NewPluginIndex("large.esp", records)
+	require.NoError(t, err)
+	require.Equal(t, len(records), idx.Len())
+}
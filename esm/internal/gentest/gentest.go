@@ -0,0 +1,89 @@
+// Package gentest holds the helpers used by the tests that
+// generator/gen.go writes for generated subrecords, and by hand-written
+// record tests.
+package gentest
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_ "
+
+// String returns a random string of at most n ASCII letters, digits,
+// underscores and spaces, so it survives every code page.
+func String(r *rand.Rand, n int) string {
+	b := make([]byte, r.IntN(n+1))
+	for i := range b {
+		b[i] = letters[r.IntN(len(letters))]
+	}
+	return string(b)
+}
+
+// Float32 returns a random finite float32.
+func Float32(r *rand.Rand) float32 {
+	return float32(r.NormFloat64() * 1e4)
+}
+
+// Marshal marshals each of fields, for building test records.
+func Marshal(t testing.TB, fields ...esm.ParsedSubrecord) []*esm.Subrecord {
+	t.Helper()
+	out := []*esm.Subrecord{}
+	for _, f := range fields {
+		sub, err := f.Marshal()
+		require.NoError(t, err)
+		out = append(out, sub)
+	}
+	return out
+}
+
+// RoundTrip checks that want marshals and unmarshals back to an equal
+// value, that the result has the size an esm.Sized reports and the
+// fields an esm.Structured lists, that the nil-receiver contract of
+// esm.ParsedSubrecord holds, and that UnmarshalTo rejects a subrecord
+// with another tag.
+func RoundTrip[T any, P interface {
+	*T
+	esm.ParsedSubrecord
+}](t *testing.T, want P) {
+	t.Helper()
+	sub, err := want.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, want.Tag(), sub.Tag)
+	if sized, ok := any(want).(esm.Sized); ok {
+		require.Len(t, sub.Data, sized.Size())
+	}
+	if structured, ok := any(want).(esm.Structured); ok {
+		end := 0
+		for _, f := range structured.Layout() {
+			require.Equal(t, end, f.Offset, f.Name)
+			if f.Size < 0 {
+				end = len(sub.Data)
+				break
+			}
+			end += f.Size
+		}
+		require.Equal(t, len(sub.Data), end)
+	}
+
+	got := P(new(T))
+	require.NoError(t, sub.UnmarshalTo(got))
+	require.Equal(t, want, got)
+
+	var none P
+	marshalled, err := none.Marshal()
+	require.NoError(t, err)
+	require.Nil(t, marshalled)
+	require.ErrorIs(t, none.Unmarshal(sub), esm.ErrArgumentNil)
+	require.ErrorIs(t, P(new(T)).Unmarshal(nil), esm.ErrArgumentNil)
+
+	other := esm.SubrecordTag("ZZZZ")
+	if want.Tag() == other {
+		other = "YYYY"
+	}
+	err = (&esm.Subrecord{Tag: other, Data: sub.Data}).UnmarshalTo(P(new(T)))
+	require.ErrorIs(t, err, esm.ErrTagMismatch)
+}
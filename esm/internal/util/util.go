@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math"
+
+	"github.com/ernmw/omwpacker/esm/codepage"
 )
 
 func WritePaddedString(out *bytes.Buffer, s []byte, size int) error {
@@ -20,11 +23,61 @@ func WritePaddedString(out *bytes.Buffer, s []byte, size int) error {
 	return nil
 }
 
+// PutPaddedString encodes s into dst, padding it with null bytes.
+func PutPaddedString(dst []byte, s string) error {
+	raw, err := EncodeString(s)
+	if err != nil {
+		return err
+	}
+	if len(raw) > len(dst) {
+		return fmt.Errorf("string too big: %d bytes, want at most %d", len(raw), len(dst))
+	}
+	clear(dst[copy(dst, raw):])
+	return nil
+}
+
+// ReadFixedString decodes a null-padded string from raw. If raw holds
+// anything but zeros after the terminator, a copy of raw is kept in
+// (*junk)[name] so PutFixedString can write it back unchanged.
+func ReadFixedString(raw []byte, junk *map[string][]byte, name string) string {
+	s := ReadPaddedString(raw)
+	if i := bytes.IndexByte(raw, 0); i >= 0 && len(bytes.TrimLeft(raw[i:], "\x00")) > 0 {
+		if *junk == nil {
+			*junk = map[string][]byte{}
+		}
+		(*junk)[name] = bytes.Clone(raw)
+	}
+	return s
+}
+
+// PutFixedString encodes s into dst like PutPaddedString, unless
+// junk[name] holds the bytes s was read from by ReadFixedString, in which
+// case those are written instead.
+func PutFixedString(dst []byte, s string, junk map[string][]byte, name string) error {
+	if raw, ok := junk[name]; ok && len(raw) == len(dst) && ReadPaddedString(raw) == s {
+		copy(dst, raw)
+		return nil
+	}
+	return PutPaddedString(dst, s)
+}
+
+// ReadPaddedString decodes raw up to the first null byte using the
+// current code page.
 func ReadPaddedString(raw []byte) string {
 	if i := bytes.IndexByte(raw, 0); i >= 0 {
-		return string(raw[:i])
+		return DecodeString(raw[:i])
 	}
-	return string(raw)
+	return DecodeString(raw)
+}
+
+// DecodeString converts raw plugin text in the current code page to UTF-8.
+func DecodeString(raw []byte) string {
+	return codepage.Current().Decode(raw)
+}
+
+// EncodeString converts s to the current code page.
+func EncodeString(s string) ([]byte, error) {
+	return codepage.Current().Encode(s)
 }
 
 func BytesToFloat32(bytes []byte) float32 {
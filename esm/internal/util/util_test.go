@@ -0,0 +1,25 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedString(t *testing.T) {
+	var junk map[string][]byte
+	clean := []byte("belt\x00\x00\x00\x00")
+	require.Equal(t, "belt", ReadFixedString(clean, &junk, "a"))
+	require.Nil(t, junk)
+
+	dirty := []byte("belt\x00\x84\xca\x08")
+	require.Equal(t, "belt", ReadFixedString(dirty, &junk, "b"))
+	require.Equal(t, map[string][]byte{"b": dirty}, junk)
+
+	dst := make([]byte, 8)
+	require.NoError(t, PutFixedString(dst, "belt", junk, "b"))
+	require.Equal(t, dirty, dst)
+	require.NoError(t, PutFixedString(dst, "shirt", junk, "b"))
+	require.Equal(t, []byte("shirt\x00\x00\x00"), dst)
+	require.Error(t, PutFixedString(dst, "too long!", junk, "a"))
+}
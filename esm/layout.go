@@ -0,0 +1,154 @@
+package esm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// LayoutField is a named range of the bytes of a subrecord.
+type LayoutField struct {
+	Name string
+	// Type is one of i8, u8, i16, u16, i32, u32 and f32 for little-endian
+	// numbers, z for a null-padded string in the current code page, or x for
+	// raw bytes.
+	Type   string
+	Offset int
+	// Size is the size of the field in bytes, or -1 if it runs to the end
+	// of the subrecord.
+	Size int
+	// Count makes the field an array of Count numbers. Zero means a
+	// single value.
+	Count int
+}
+
+// Layout lists the fields of a subrecord in order.
+type Layout []LayoutField
+
+// Structured is implemented by parsed subrecords with a known layout.
+// Subrecords with several layouts return the one that matches the
+// receiver's value.
+type Structured interface {
+	Layout() Layout
+}
+
+// numberSizes are the sizes of the numeric layout types.
+var numberSizes = map[string]int{
+	"i8": 1, "u8": 1,
+	"i16": 2, "u16": 2,
+	"i32": 4, "u32": 4, "f32": 4,
+}
+
+// ParseLayout parses a comma-separated layout spec such as
+// "f32,u32,z32,u8[4]". Each item is an optional "name:" followed by a
+// number type with an optional [count], or zN or xN for an N byte string
+// or byte run. A bare z or x runs to the end and must come last. Unnamed
+// fields are named by their offset.
+func ParseLayout(spec string) (Layout, error) {
+	layout := Layout{}
+	offset := 0
+	items := strings.Split(spec, ",")
+	for i, item := range items {
+		item = strings.TrimSpace(item)
+		name, typ, named := strings.Cut(item, ":")
+		if !named {
+			name, typ = "", item
+		}
+		f := LayoutField{Name: name, Offset: offset}
+		if open := strings.IndexByte(typ, '['); open >= 0 && strings.HasSuffix(typ, "]") {
+			count, err := strconv.Atoi(typ[open+1 : len(typ)-1])
+			if err != nil || count <= 0 {
+				return nil, fmt.Errorf("bad count in %q", item)
+			}
+			f.Count = count
+			typ = typ[:open]
+		}
+		f.Type = typ
+		if size, ok := numberSizes[typ]; ok {
+			f.Size = size * max(f.Count, 1)
+		} else if typ == "" {
+			return nil, fmt.Errorf("empty field %d", i+1)
+		} else if kind := typ[:1]; (kind == "z" || kind == "x") && f.Count == 0 {
+			f.Type = kind
+			if typ == kind {
+				if i != len(items)-1 {
+					return nil, fmt.Errorf("%q runs to the end, so it must come last", item)
+				}
+				f.Size = -1
+			} else if size, err := strconv.Atoi(typ[1:]); err == nil && size > 0 {
+				f.Size = size
+			} else {
+				return nil, fmt.Errorf("bad size in %q", item)
+			}
+		} else {
+			return nil, fmt.Errorf("unknown type in %q", item)
+		}
+		if f.Name == "" {
+			f.Name = fmt.Sprintf("+%d", offset)
+		}
+		layout = append(layout, f)
+		offset += f.Size
+	}
+	return layout, nil
+}
+
+// Bytes returns the part of data that f covers. It is shorter than f's
+// size if data is.
+func (f LayoutField) Bytes(data []byte) []byte {
+	if f.Offset >= len(data) {
+		return nil
+	}
+	if f.Size < 0 || f.Offset+f.Size > len(data) {
+		return data[f.Offset:]
+	}
+	return data[f.Offset : f.Offset+f.Size]
+}
+
+// Value decodes f from data. Arrays decode to slices.
+func (f LayoutField) Value(data []byte) (any, error) {
+	raw := f.Bytes(data)
+	if f.Size >= 0 && len(raw) < f.Size {
+		return nil, fmt.Errorf("%s needs %d bytes, %d left", f.Name, f.Size, len(raw))
+	}
+	switch f.Type {
+	case "z":
+		return util.ReadPaddedString(raw), nil
+	case "x":
+		return raw, nil
+	}
+	size, ok := numberSizes[f.Type]
+	if !ok {
+		return nil, fmt.Errorf("%s has unknown type %q", f.Name, f.Type)
+	}
+	if f.Count == 0 {
+		return number(f.Type, raw), nil
+	}
+	values := make([]any, f.Count)
+	for i := range values {
+		values[i] = number(f.Type, raw[i*size:(i+1)*size])
+	}
+	return values, nil
+}
+
+func number(typ string, raw []byte) any {
+	switch typ {
+	case "i8":
+		return int8(raw[0])
+	case "u8":
+		return raw[0]
+	case "i16":
+		return int16(binary.LittleEndian.Uint16(raw))
+	case "u16":
+		return binary.LittleEndian.Uint16(raw)
+	case "i32":
+		return int32(binary.LittleEndian.Uint32(raw))
+	case "u32":
+		return binary.LittleEndian.Uint32(raw)
+	default:
+		return math.Float32frombits(binary.LittleEndian.Uint32(raw))
+	}
+}
@@ -0,0 +1,54 @@
+package esm_test
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/codepage"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLayout(t *testing.T) {
+	layout, err := esm.ParseLayout("f32, count:u32,z8,u8[3],i16,x")
+	require.NoError(t, err)
+	require.Equal(t, esm.Layout{
+		{Name: "+0", Type: "f32", Offset: 0, Size: 4},
+		{Name: "count", Type: "u32", Offset: 4, Size: 4},
+		{Name: "+8", Type: "z", Offset: 8, Size: 8},
+		{Name: "+16", Type: "u8", Offset: 16, Size: 3, Count: 3},
+		{Name: "+19", Type: "i16", Offset: 19, Size: 2},
+		{Name: "+21", Type: "x", Offset: 21, Size: -1},
+	}, layout)
+
+	data := []byte{
+		0, 0, 0xc0, 0x3f,
+		7, 0, 0, 0,
+		'a', 'b', 0, 'j', 'u', 'n', 'k', 0,
+		1, 2, 3,
+		0xfe, 0xff,
+		9, 8,
+	}
+	want := []any{float32(1.5), uint32(7), "ab", []any{uint8(1), uint8(2), uint8(3)}, int16(-2), []byte{9, 8}}
+	for i, f := range layout {
+		v, err := f.Value(data)
+		require.NoError(t, err, f.Name)
+		require.Equal(t, want[i], v, f.Name)
+	}
+
+	_, err = layout[1].Value(data[:6])
+	require.Error(t, err)
+	require.Equal(t, []byte{7, 0}, layout[1].Bytes(data[:6]))
+	require.Nil(t, layout[2].Bytes(data[:6]))
+
+	// Strings are decoded from the current code page.
+	codepage.Set(codepage.Win1251)
+	defer codepage.Set(codepage.Win1252)
+	v, err := layout[2].Value([]byte("12345678\xc4\xe0\x00\x00\x00\x00\x00\x00"))
+	require.NoError(t, err)
+	require.Equal(t, "Да", v)
+
+	for _, bad := range []string{"", "u64", "u8[0]", "z0", "z[2]", "x,u8", "q:"} {
+		_, err := esm.ParseLayout(bad)
+		require.Error(t, err, bad)
+	}
+}
@@ -0,0 +1,115 @@
+package esm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// Plugin is one parsed plugin of a load order.
+type Plugin struct {
+	Path    string
+	Records []*Record
+}
+
+// PluginError is a failure to load the plugin at Path.
+type PluginError struct {
+	Path string
+	Err  error
+}
+
+func (e *PluginError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+func (e *PluginError) Unwrap() error {
+	return e.Err
+}
+
+// LoadProgress is called after each plugin finishes loading, successfully
+// or not. done counts finished plugins out of total. Calls are serialized.
+type LoadProgress func(done, total int, path string, err error)
+
+// LoadOptions configures LoadPlugins.
+type LoadOptions struct {
+	// Workers bounds how many plugins are parsed at once.
+	// Zero or less uses runtime.GOMAXPROCS(0).
+	Workers int
+	// Progress, if set, is called as plugins finish.
+	Progress LoadProgress
+	// Parse is passed to ParsePluginFile for every plugin.
+	Parse []ParseOption
+	// Keep, if set, selects the records to keep of each plugin. The rest
+	// are dropped as soon as the plugin is parsed.
+	Keep func(*Record) bool
+}
+
+// LoadPlugins parses the plugins at paths in parallel.
+// The result has one entry per path, in the same order; entries for
+// plugins that failed or were never started have nil Records.
+// The returned error joins a *PluginError for every failed plugin, and
+// ctx.Err() if ctx was cancelled before every plugin was started.
+// Plugins already being parsed when ctx is cancelled are finished.
+func LoadPlugins(ctx context.Context, paths []string, opts LoadOptions) ([]*Plugin, error) {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(paths))
+
+	plugins := make([]*Plugin, len(paths))
+	errs := make([]error, len(paths))
+	for i, path := range paths {
+		plugins[i] = &Plugin{Path: path}
+	}
+
+	jobs := make(chan int)
+	var progress sync.Mutex
+	done := 0
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				records, err := ParsePluginFile(paths[i], opts.Parse...)
+				if err != nil {
+					errs[i] = &PluginError{Path: paths[i], Err: err}
+				} else {
+					if opts.Keep != nil {
+						records = slices.DeleteFunc(records, func(rec *Record) bool { return !opts.Keep(rec) })
+					}
+					plugins[i].Records = records
+				}
+				if opts.Progress != nil {
+					progress.Lock()
+					done++
+					opts.Progress(done, len(paths), paths[i], err)
+					progress.Unlock()
+				}
+			}
+		}()
+	}
+
+	var cancelled error
+feed:
+	for i := range paths {
+		if cancelled = ctx.Err(); cancelled != nil {
+			break
+		}
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			cancelled = ctx.Err()
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	errs = append(errs, cancelled)
+	return plugins, errors.Join(errs...)
+}
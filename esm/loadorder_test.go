@@ -0,0 +1,69 @@
+package esm_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPlugins(t *testing.T) {
+	paths := []string{
+		"testdata/large.esp",
+		"testdata/missing.esp",
+		"testdata/large.esp",
+		"testdata/also-missing.omwaddon",
+		"testdata/large.esp",
+	}
+	want, err := esm.ParsePluginFile(paths[0])
+	require.NoError(t, err)
+
+	// Progress runs on the workers, one call at a time, so it only records
+	// what it is given; the checks happen back on the test goroutine.
+	var dones, totals []int
+	plugins, err := esm.LoadPlugins(context.Background(), paths, esm.LoadOptions{
+		Workers: 2,
+		Progress: func(done, total int, path string, err error) {
+			dones = append(dones, done)
+			totals = append(totals, total)
+		},
+	})
+	require.Equal(t, []int{1, 2, 3, 4, 5}, dones)
+	require.Equal(t, slices.Repeat([]int{len(paths)}, len(paths)), totals)
+	require.Len(t, plugins, len(paths))
+	for i, p := range plugins {
+		require.Equal(t, paths[i], p.Path)
+	}
+	require.Equal(t, want, plugins[0].Records)
+	require.Nil(t, plugins[1].Records)
+	require.Equal(t, want, plugins[4].Records)
+
+	failed := []string{}
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var pe *esm.PluginError
+		require.True(t, errors.As(e, &pe))
+		failed = append(failed, pe.Path)
+	}
+	require.ElementsMatch(t, []string{paths[1], paths[3]}, failed)
+
+	t.Run("keep", func(t *testing.T) {
+		plugins, err := esm.LoadPlugins(context.Background(), paths[:1], esm.LoadOptions{
+			Keep: func(rec *esm.Record) bool { return rec.Tag == "CELL" },
+		})
+		require.NoError(t, err)
+		cells := slices.DeleteFunc(slices.Clone(want), func(rec *esm.Record) bool { return rec.Tag != "CELL" })
+		require.NotEmpty(t, cells)
+		require.Equal(t, cells, plugins[0].Records)
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		plugins, err := esm.LoadPlugins(ctx, paths[:1], esm.LoadOptions{})
+		require.Len(t, plugins, 1)
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
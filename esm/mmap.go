@@ -0,0 +1,89 @@
+package esm
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// MappedPlugin is a plugin whose records point into a memory-mapped copy
+// of its file, so parsing allocates only the Record and Subrecord structs.
+//
+// Every Subrecord.Data slice is only valid until Close. Use Record.Clone to
+// keep a record longer. The mapping is private: writing to Data changes
+// only this process's copy of the affected pages, never the file.
+type MappedPlugin struct {
+	Path    string
+	Records []*Record
+
+	once  sync.Once
+	unmap func() error
+	err   error
+}
+
+// OpenMapped maps the plugin at path into memory and parses it.
+// On platforms without mmap the file is read into memory instead.
+// Callers must Close the result when done with its records.
+func OpenMapped(path string, opts ...ParseOption) (*MappedPlugin, error) {
+	data, unmap, err := mapFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("map %q: %w", path, err)
+	}
+	records, err := parseRecords(strings.ToLower(filepath.Base(path)), data, NewParseOptions(opts...))
+	if err != nil {
+		unmap()
+		return nil, err
+	}
+	return &MappedPlugin{Path: path, Records: records, unmap: unmap}, nil
+}
+
+// Close unmaps the file. Subrecord data of the plugin's records must not
+// be used afterwards. Closing more than once is harmless.
+func (m *MappedPlugin) Close() error {
+	m.once.Do(func() {
+		m.err = m.unmap()
+		m.Records = nil
+	})
+	return m.err
+}
+
+// parseRecords parses a whole plugin held in memory. Subrecord data
+// slices data rather than copying it.
+func parseRecords(pluginName string, data []byte, opts *ParseOptions) ([]*Record, error) {
+	records := []*Record{}
+	pos := 0
+	for pos < len(data) {
+		offset := int64(pos)
+		if pos+16 > len(data) {
+			if opts.Lenient {
+				opts.report(Diagnostic{Plugin: pluginName, Offset: offset, Message: fmt.Sprintf("ignored %d bytes of junk after the last record", len(data)-pos)})
+				break
+			}
+			return nil, io.ErrUnexpectedEOF
+		}
+		rec, size, err := parseRecordHeader(data[pos:pos+16], pluginName, offset, opts)
+		if err != nil {
+			return nil, err
+		}
+		if rec == nil {
+			reportCorruptHeader(opts, pluginName, offset, data[pos:pos+16], int64(len(data)-pos))
+			break
+		}
+		pos += 16
+		if uint64(pos)+uint64(size) > uint64(len(data)) {
+			if opts.Lenient {
+				opts.report(Diagnostic{Plugin: pluginName, Offset: offset, Record: rec.Tag, Message: fmt.Sprintf("dropped record truncated before its %d byte body ended", size)})
+				break
+			}
+			return nil, fmt.Errorf("record %q: %w", rec.Tag, io.ErrUnexpectedEOF)
+		}
+		if err := parseSubrecords(rec, data[pos:pos+int(size)], opts); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+		pos += int(size)
+	}
+	return records, nil
+}
@@ -0,0 +1,98 @@
+package esm_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+const benchmarkPlugin = "testdata/large.esp"
+
+func TestOpenMapped(t *testing.T) {
+	want, err := esm.ParsePluginFile(benchmarkPlugin)
+	require.NoError(t, err)
+
+	mapped, err := esm.OpenMapped(benchmarkPlugin)
+	require.NoError(t, err)
+	require.Equal(t, want, mapped.Records)
+
+	t.Run("writes are private", func(t *testing.T) {
+		raw, err := os.ReadFile(benchmarkPlugin)
+		require.NoError(t, err)
+		path := filepath.Join(t.TempDir(), "copy.esp")
+		require.NoError(t, os.WriteFile(path, raw, 0644))
+
+		m, err := esm.OpenMapped(path)
+		require.NoError(t, err)
+		defer m.Close()
+		name := m.Records[0].Subrecords[0].Data
+		name[0] ^= 0xff
+
+		again, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Equal(t, raw, again)
+	})
+
+	t.Run("clones outlive close", func(t *testing.T) {
+		kept := []*esm.Record{}
+		for _, rec := range mapped.Records {
+			kept = append(kept, rec.Clone())
+		}
+		require.NoError(t, mapped.Close())
+		require.NoError(t, mapped.Close())
+		require.Nil(t, mapped.Records)
+		require.Equal(t, want, kept)
+	})
+
+	t.Run("appends don't clobber neighbours", func(t *testing.T) {
+		m, err := esm.OpenMapped(benchmarkPlugin)
+		require.NoError(t, err)
+		defer m.Close()
+		subs := m.Records[1].Subrecords
+		next := bytes.Clone(subs[1].Data)
+		subs[0].Data = append(subs[0].Data, 1, 2, 3, 4)
+		require.Equal(t, next, subs[1].Data)
+	})
+
+	t.Run("empty and truncated files", func(t *testing.T) {
+		dir := t.TempDir()
+		empty := filepath.Join(dir, "empty.esp")
+		require.NoError(t, os.WriteFile(empty, nil, 0644))
+		m, err := esm.OpenMapped(empty)
+		require.NoError(t, err)
+		require.Empty(t, m.Records)
+		require.NoError(t, m.Close())
+
+		var buf bytes.Buffer
+		require.NoError(t, esm.WriteRecords(&buf, slices.Values(want[:2])))
+		truncated := filepath.Join(dir, "truncated.esp")
+		require.NoError(t, os.WriteFile(truncated, buf.Bytes()[:buf.Len()-1], 0644))
+		_, err = esm.OpenMapped(truncated)
+		require.Error(t, err)
+	})
+}
+
+func BenchmarkParsePluginFile(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		if _, err := esm.ParsePluginFile(benchmarkPlugin); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkOpenMapped(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		m, err := esm.OpenMapped(benchmarkPlugin)
+		if err != nil {
+			b.Fatal(err)
+		}
+		m.Close()
+	}
+}
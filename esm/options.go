@@ -0,0 +1,64 @@
+package esm
+
+import "errors"
+
+// ErrLimitExceeded is returned when a plugin exceeds a configured ParseOptions limit.
+var ErrLimitExceeded = errors.New("limit exceeded")
+
+const (
+	// DefaultMaxRecordSize is the default largest record body, in bytes.
+	// Vanilla records are at most a few hundred kilobytes.
+	DefaultMaxRecordSize uint32 = 64 << 20
+	// DefaultMaxSubrecords is the default most subrecords in one record.
+	DefaultMaxSubrecords = 1 << 20
+)
+
+// ParseOptions control how plugins are parsed. Build them with ParseOption funcs.
+type ParseOptions struct {
+	// MaxRecordSize is the largest record body, in bytes, the parser accepts.
+	MaxRecordSize uint32
+	// MaxSubrecords is the most subrecords a single record may contain.
+	MaxSubrecords int
+	// Lenient parsers skip or repair malformed data instead of failing.
+	Lenient bool
+	// Report, if set, receives a Diagnostic for every repair.
+	Report func(Diagnostic)
+}
+
+// ParseOption adjusts ParseOptions.
+type ParseOption func(*ParseOptions)
+
+// NewParseOptions applies opts on top of the defaults.
+func NewParseOptions(opts ...ParseOption) *ParseOptions {
+	o := &ParseOptions{
+		MaxRecordSize: DefaultMaxRecordSize,
+		MaxSubrecords: DefaultMaxSubrecords,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithMaxRecordSize limits the size of a record body, in bytes.
+func WithMaxRecordSize(n uint32) ParseOption {
+	return func(o *ParseOptions) { o.MaxRecordSize = n }
+}
+
+// WithMaxSubrecords limits the number of subrecords in a record.
+func WithMaxSubrecords(n int) ParseOption {
+	return func(o *ParseOptions) { o.MaxSubrecords = n }
+}
+
+// WithLenient makes parsers recover from malformed data where they can
+// instead of failing, reporting what they did as Diagnostics.
+func WithLenient() ParseOption {
+	return func(o *ParseOptions) { o.Lenient = true }
+}
+
+// WithDiagnostics calls report for every problem a lenient parser
+// recovers from. When the same options are shared by LoadPlugins, report
+// is called from several goroutines.
+func WithDiagnostics(report func(Diagnostic)) ParseOption {
+	return func(o *ParseOptions) { o.Report = report }
+}
@@ -0,0 +1,26 @@
+// ACTI records contain activators.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package acti
+
+import "github.com/ernmw/omwpacker/esm"
+
+// ACTI handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/ACTI
+const ACTI esm.RecordTag = "ACTI"
+
+func init() {
+	esm.RegisterRecord(ACTI, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		a, err := ParseActivator(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return a, nil
+	})
+	esm.RegisterSubrecords(ACTI,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&SCRIField{},
+	)
+}
@@ -0,0 +1,112 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package acti
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// ActivatorRecord is an object the player can activate, such as a sign or a lever.
+type ActivatorRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	SCRI *SCRIField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// activatorRecordFields lists the tag that starts each field of ActivatorRecord.
+var activatorRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, SCRI}
+
+func (r *ActivatorRecord) Tag() esm.RecordTag { return ACTI }
+
+func (r *ActivatorRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseActivator builds a ActivatorRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseActivator(rec *esm.Record, opts ...esm.ParseOption) (*ActivatorRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != ACTI {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseActivatorRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseActivatorRecord parses the ActivatorRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseActivatorRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*ActivatorRecord, int, error) {
+	r := &ActivatorRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(activatorRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,181 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package acti
+
+import (
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Activator name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Activator name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Activator ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Activator ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
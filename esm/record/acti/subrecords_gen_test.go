@@ -0,0 +1,34 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package acti
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("MODL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MODLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SCRI", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCRIField{Value: gentest.String(r, 64)})
+		}
+	})
+}
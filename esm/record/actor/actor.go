@@ -0,0 +1,27 @@
+// Package actor contains the subrecords and groups that NPC_ and CREA
+// records share: inventory, spells, AI settings, travel destinations and
+// AI packages. It registers nothing itself; the record packages do.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package actor
+
+import "github.com/ernmw/omwpacker/esm"
+
+// Subrecords returns a prototype of every shared subrecord, for record
+// packages to pass to esm.RegisterSubrecords along with their own.
+func Subrecords() []esm.ParsedSubrecord {
+	return []esm.ParsedSubrecord{
+		&NPCOField{},
+		&NPCSField{},
+		&AIDTField{},
+		&DODTField{},
+		&DNAMField{},
+		&AI_WField{},
+		&AI_TField{},
+		&AI_FField{},
+		&AI_EField{},
+		&AI_AField{},
+		&CNDTField{},
+	}
+}
@@ -0,0 +1,27 @@
+package actor
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNPCOPadding(t *testing.T) {
+	raw := make([]byte, 36)
+	raw[0] = 3
+	copy(raw[4:], "gold_001\x00garbage")
+	var item NPCOField
+	require.NoError(t, (&esm.Subrecord{Tag: NPCO, Data: raw}).UnmarshalTo(&item))
+	require.Equal(t, int32(3), item.Count)
+	require.Equal(t, "gold_001", item.Item)
+
+	sub, err := item.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, raw, sub.Data)
+
+	item.Item = "gold_005"
+	sub, err = item.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, "gold_005\x00\x00\x00", string(sub.Data[4:15]))
+}
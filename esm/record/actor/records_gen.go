@@ -0,0 +1,415 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package actor
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// AIPackage is one of AIWander, AITravel, AIFollow, AIEscort or AIActivate.
+type AIPackage interface {
+	esm.Ordered
+	isAIPackage()
+}
+
+func (*AIWander) isAIPackage() {}
+
+func (*AITravel) isAIPackage() {}
+
+func (*AIFollow) isAIPackage() {}
+
+func (*AIEscort) isAIPackage() {}
+
+func (*AIActivate) isAIPackage() {}
+
+// TravelDestination is a place an actor offers travel to.
+type TravelDestination struct {
+	DODT *DODTField
+	DNAM *DNAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// travelDestinationFields lists the tag that starts each field of TravelDestination.
+var travelDestinationFields = []esm.SubrecordTag{DODT, DNAM}
+
+func (r *TravelDestination) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.DODT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DNAM); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseTravelDestination parses the TravelDestination starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+// Records of other packages use it to parse their TravelDestination fields.
+func ParseTravelDestination(rec *esm.Record, start int, o *esm.ParseOptions) (*TravelDestination, int, error) {
+	r := &TravelDestination{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(travelDestinationFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.DODT != nil {
+				break fields
+			}
+			r.DODT, err = esm.ParseField[DODTField](f, i)
+		case 1:
+			if r.DNAM != nil {
+				break fields
+			}
+			r.DNAM, err = esm.ParseField[DNAMField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.DODT == nil {
+		if err := f.Missing(DODT); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// AIWander makes the actor wander around.
+type AIWander struct {
+	AI_W *AI_WField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// aIWanderFields lists the tag that starts each field of AIWander.
+var aIWanderFields = []esm.SubrecordTag{AI_W}
+
+func (r *AIWander) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.AI_W); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseAIWander parses the AIWander starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+// Records of other packages use it to parse their AIWander fields.
+func ParseAIWander(rec *esm.Record, start int, o *esm.ParseOptions) (*AIWander, int, error) {
+	r := &AIWander{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(aIWanderFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.AI_W != nil {
+				break fields
+			}
+			r.AI_W, err = esm.ParseField[AI_WField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.AI_W == nil {
+		if err := f.Missing(AI_W); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// AITravel makes the actor travel to a point.
+type AITravel struct {
+	AI_T *AI_TField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// aITravelFields lists the tag that starts each field of AITravel.
+var aITravelFields = []esm.SubrecordTag{AI_T}
+
+func (r *AITravel) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.AI_T); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseAITravel parses the AITravel starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+// Records of other packages use it to parse their AITravel fields.
+func ParseAITravel(rec *esm.Record, start int, o *esm.ParseOptions) (*AITravel, int, error) {
+	r := &AITravel{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(aITravelFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.AI_T != nil {
+				break fields
+			}
+			r.AI_T, err = esm.ParseField[AI_TField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.AI_T == nil {
+		if err := f.Missing(AI_T); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// AIFollow makes the actor follow another.
+type AIFollow struct {
+	AI_F *AI_FField
+	CNDT *CNDTField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// aIFollowFields lists the tag that starts each field of AIFollow.
+var aIFollowFields = []esm.SubrecordTag{AI_F, CNDT}
+
+func (r *AIFollow) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.AI_F); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNDT); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseAIFollow parses the AIFollow starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+// Records of other packages use it to parse their AIFollow fields.
+func ParseAIFollow(rec *esm.Record, start int, o *esm.ParseOptions) (*AIFollow, int, error) {
+	r := &AIFollow{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(aIFollowFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.AI_F != nil {
+				break fields
+			}
+			r.AI_F, err = esm.ParseField[AI_FField](f, i)
+		case 1:
+			if r.CNDT != nil {
+				break fields
+			}
+			r.CNDT, err = esm.ParseField[CNDTField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.AI_F == nil {
+		if err := f.Missing(AI_F); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// AIEscort makes the actor escort another.
+type AIEscort struct {
+	AI_E *AI_EField
+	CNDT *CNDTField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// aIEscortFields lists the tag that starts each field of AIEscort.
+var aIEscortFields = []esm.SubrecordTag{AI_E, CNDT}
+
+func (r *AIEscort) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.AI_E); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNDT); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseAIEscort parses the AIEscort starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+// Records of other packages use it to parse their AIEscort fields.
+func ParseAIEscort(rec *esm.Record, start int, o *esm.ParseOptions) (*AIEscort, int, error) {
+	r := &AIEscort{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(aIEscortFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.AI_E != nil {
+				break fields
+			}
+			r.AI_E, err = esm.ParseField[AI_EField](f, i)
+		case 1:
+			if r.CNDT != nil {
+				break fields
+			}
+			r.CNDT, err = esm.ParseField[CNDTField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.AI_E == nil {
+		if err := f.Missing(AI_E); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// AIActivate makes the actor activate an object.
+type AIActivate struct {
+	AI_A *AI_AField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// aIActivateFields lists the tag that starts each field of AIActivate.
+var aIActivateFields = []esm.SubrecordTag{AI_A}
+
+func (r *AIActivate) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.AI_A); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseAIActivate parses the AIActivate starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+// Records of other packages use it to parse their AIActivate fields.
+func ParseAIActivate(rec *esm.Record, start int, o *esm.ParseOptions) (*AIActivate, int, error) {
+	r := &AIActivate{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(aIActivateFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.AI_A != nil {
+				break fields
+			}
+			r.AI_A, err = esm.ParseField[AI_AField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.AI_A == nil {
+		if err := f.Missing(AI_A); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
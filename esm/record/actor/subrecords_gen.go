@@ -0,0 +1,817 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package actor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Cell of the previous follow or escort package.
+const CNDT esm.SubrecordTag = "CNDT"
+
+// Cell of the previous follow or escort package.
+type CNDTField struct{ Value string }
+
+func (t *CNDTField) Tag() esm.SubrecordTag { return CNDT }
+
+// Layout implements esm.Structured.
+func (t *CNDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CNDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *CNDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CNDT: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Activate AI package.
+const AI_A esm.SubrecordTag = "AI_A"
+
+// Activate AI package.
+type AI_AField struct {
+	// ID of the object to activate.
+	Target       string
+	ShouldRepeat uint8
+	// Junk holds the raw bytes of fixed-length strings that had data after
+	// their terminator, by field name, so they survive a round trip.
+	Junk map[string][]byte
+}
+
+func (t *AI_AField) Tag() esm.SubrecordTag { return AI_A }
+
+// Size implements esm.Sized.
+func (t *AI_AField) Size() int { return 33 }
+
+// Layout implements esm.Structured.
+func (t *AI_AField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Target", Type: "z", Offset: 0, Size: 32},
+		{Name: "ShouldRepeat", Type: "u8", Offset: 32, Size: 1},
+	}
+}
+
+func (s *AI_AField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 33 {
+		return fmt.Errorf("AI_A must be 33 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Junk = nil
+	s.Target = util.ReadFixedString(d[0:32], &s.Junk, "Target")
+	s.ShouldRepeat = d[32]
+	return nil
+}
+
+func (s *AI_AField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 33)
+	if err := util.PutFixedString(d[0:32], s.Target, s.Junk, "Target"); err != nil {
+		return nil, fmt.Errorf("AI_A.Target: %w", err)
+	}
+	d[32] = byte(s.ShouldRepeat)
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Escort AI package.
+const AI_E esm.SubrecordTag = "AI_E"
+
+// Escort AI package.
+type AI_EField struct {
+	X float32
+	Y float32
+	Z float32
+	// Hours.
+	Duration int16
+	// ID of the actor to escort.
+	Target       string
+	ShouldRepeat uint8
+	Padding      uint8
+	// Junk holds the raw bytes of fixed-length strings that had data after
+	// their terminator, by field name, so they survive a round trip.
+	Junk map[string][]byte
+}
+
+func (t *AI_EField) Tag() esm.SubrecordTag { return AI_E }
+
+// Size implements esm.Sized.
+func (t *AI_EField) Size() int { return 48 }
+
+// Layout implements esm.Structured.
+func (t *AI_EField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "X", Type: "f32", Offset: 0, Size: 4},
+		{Name: "Y", Type: "f32", Offset: 4, Size: 4},
+		{Name: "Z", Type: "f32", Offset: 8, Size: 4},
+		{Name: "Duration", Type: "i16", Offset: 12, Size: 2},
+		{Name: "Target", Type: "z", Offset: 14, Size: 32},
+		{Name: "ShouldRepeat", Type: "u8", Offset: 46, Size: 1},
+		{Name: "Padding", Type: "u8", Offset: 47, Size: 1},
+	}
+}
+
+func (s *AI_EField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 48 {
+		return fmt.Errorf("AI_E must be 48 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Junk = nil
+	s.X = math.Float32frombits(binary.LittleEndian.Uint32(d[0:4]))
+	s.Y = math.Float32frombits(binary.LittleEndian.Uint32(d[4:8]))
+	s.Z = math.Float32frombits(binary.LittleEndian.Uint32(d[8:12]))
+	s.Duration = int16(binary.LittleEndian.Uint16(d[12:14]))
+	s.Target = util.ReadFixedString(d[14:46], &s.Junk, "Target")
+	s.ShouldRepeat = d[46]
+	s.Padding = d[47]
+	return nil
+}
+
+func (s *AI_EField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 48)
+	binary.LittleEndian.PutUint32(d[0:4], math.Float32bits(float32(s.X)))
+	binary.LittleEndian.PutUint32(d[4:8], math.Float32bits(float32(s.Y)))
+	binary.LittleEndian.PutUint32(d[8:12], math.Float32bits(float32(s.Z)))
+	binary.LittleEndian.PutUint16(d[12:14], uint16(s.Duration))
+	if err := util.PutFixedString(d[14:46], s.Target, s.Junk, "Target"); err != nil {
+		return nil, fmt.Errorf("AI_E.Target: %w", err)
+	}
+	d[46] = byte(s.ShouldRepeat)
+	d[47] = byte(s.Padding)
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Follow AI package.
+const AI_F esm.SubrecordTag = "AI_F"
+
+// Follow AI package.
+type AI_FField struct {
+	X float32
+	Y float32
+	Z float32
+	// Hours.
+	Duration int16
+	// ID of the actor to follow.
+	Target       string
+	ShouldRepeat uint8
+	Padding      uint8
+	// Junk holds the raw bytes of fixed-length strings that had data after
+	// their terminator, by field name, so they survive a round trip.
+	Junk map[string][]byte
+}
+
+func (t *AI_FField) Tag() esm.SubrecordTag { return AI_F }
+
+// Size implements esm.Sized.
+func (t *AI_FField) Size() int { return 48 }
+
+// Layout implements esm.Structured.
+func (t *AI_FField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "X", Type: "f32", Offset: 0, Size: 4},
+		{Name: "Y", Type: "f32", Offset: 4, Size: 4},
+		{Name: "Z", Type: "f32", Offset: 8, Size: 4},
+		{Name: "Duration", Type: "i16", Offset: 12, Size: 2},
+		{Name: "Target", Type: "z", Offset: 14, Size: 32},
+		{Name: "ShouldRepeat", Type: "u8", Offset: 46, Size: 1},
+		{Name: "Padding", Type: "u8", Offset: 47, Size: 1},
+	}
+}
+
+func (s *AI_FField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 48 {
+		return fmt.Errorf("AI_F must be 48 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Junk = nil
+	s.X = math.Float32frombits(binary.LittleEndian.Uint32(d[0:4]))
+	s.Y = math.Float32frombits(binary.LittleEndian.Uint32(d[4:8]))
+	s.Z = math.Float32frombits(binary.LittleEndian.Uint32(d[8:12]))
+	s.Duration = int16(binary.LittleEndian.Uint16(d[12:14]))
+	s.Target = util.ReadFixedString(d[14:46], &s.Junk, "Target")
+	s.ShouldRepeat = d[46]
+	s.Padding = d[47]
+	return nil
+}
+
+func (s *AI_FField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 48)
+	binary.LittleEndian.PutUint32(d[0:4], math.Float32bits(float32(s.X)))
+	binary.LittleEndian.PutUint32(d[4:8], math.Float32bits(float32(s.Y)))
+	binary.LittleEndian.PutUint32(d[8:12], math.Float32bits(float32(s.Z)))
+	binary.LittleEndian.PutUint16(d[12:14], uint16(s.Duration))
+	if err := util.PutFixedString(d[14:46], s.Target, s.Junk, "Target"); err != nil {
+		return nil, fmt.Errorf("AI_F.Target: %w", err)
+	}
+	d[46] = byte(s.ShouldRepeat)
+	d[47] = byte(s.Padding)
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Travel AI package.
+const AI_T esm.SubrecordTag = "AI_T"
+
+// Travel AI package.
+type AI_TField struct {
+	X            float32
+	Y            float32
+	Z            float32
+	ShouldRepeat uint8
+	Padding      [3]uint8
+}
+
+func (t *AI_TField) Tag() esm.SubrecordTag { return AI_T }
+
+// Size implements esm.Sized.
+func (t *AI_TField) Size() int { return 16 }
+
+// Layout implements esm.Structured.
+func (t *AI_TField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "X", Type: "f32", Offset: 0, Size: 4},
+		{Name: "Y", Type: "f32", Offset: 4, Size: 4},
+		{Name: "Z", Type: "f32", Offset: 8, Size: 4},
+		{Name: "ShouldRepeat", Type: "u8", Offset: 12, Size: 1},
+		{Name: "Padding", Type: "u8", Offset: 13, Size: 3, Count: 3},
+	}
+}
+
+func (s *AI_TField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 16 {
+		return fmt.Errorf("AI_T must be 16 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.X = math.Float32frombits(binary.LittleEndian.Uint32(d[0:4]))
+	s.Y = math.Float32frombits(binary.LittleEndian.Uint32(d[4:8]))
+	s.Z = math.Float32frombits(binary.LittleEndian.Uint32(d[8:12]))
+	s.ShouldRepeat = d[12]
+	for i := range s.Padding {
+		s.Padding[i] = d[13+i]
+	}
+	return nil
+}
+
+func (s *AI_TField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 16)
+	binary.LittleEndian.PutUint32(d[0:4], math.Float32bits(float32(s.X)))
+	binary.LittleEndian.PutUint32(d[4:8], math.Float32bits(float32(s.Y)))
+	binary.LittleEndian.PutUint32(d[8:12], math.Float32bits(float32(s.Z)))
+	d[12] = byte(s.ShouldRepeat)
+	for i := range s.Padding {
+		d[13+i] = byte(s.Padding[i])
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Wander AI package.
+const AI_W esm.SubrecordTag = "AI_W"
+
+// Wander AI package.
+type AI_WField struct {
+	Distance int16
+	// Hours.
+	Duration  int16
+	TimeOfDay uint8
+	// Chances of idles 2 through 9.
+	Idle         [8]uint8
+	ShouldRepeat uint8
+}
+
+func (t *AI_WField) Tag() esm.SubrecordTag { return AI_W }
+
+// Size implements esm.Sized.
+func (t *AI_WField) Size() int { return 14 }
+
+// Layout implements esm.Structured.
+func (t *AI_WField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Distance", Type: "i16", Offset: 0, Size: 2},
+		{Name: "Duration", Type: "i16", Offset: 2, Size: 2},
+		{Name: "TimeOfDay", Type: "u8", Offset: 4, Size: 1},
+		{Name: "Idle", Type: "u8", Offset: 5, Size: 8, Count: 8},
+		{Name: "ShouldRepeat", Type: "u8", Offset: 13, Size: 1},
+	}
+}
+
+func (s *AI_WField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 14 {
+		return fmt.Errorf("AI_W must be 14 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Distance = int16(binary.LittleEndian.Uint16(d[0:2]))
+	s.Duration = int16(binary.LittleEndian.Uint16(d[2:4]))
+	s.TimeOfDay = d[4]
+	for i := range s.Idle {
+		s.Idle[i] = d[5+i]
+	}
+	s.ShouldRepeat = d[13]
+	return nil
+}
+
+func (s *AI_WField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 14)
+	binary.LittleEndian.PutUint16(d[0:2], uint16(s.Distance))
+	binary.LittleEndian.PutUint16(d[2:4], uint16(s.Duration))
+	d[4] = byte(s.TimeOfDay)
+	for i := range s.Idle {
+		d[5+i] = byte(s.Idle[i])
+	}
+	d[13] = byte(s.ShouldRepeat)
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Cell name of the previous DODT, if interior.
+const DNAM esm.SubrecordTag = "DNAM"
+
+// Cell name of the previous DODT, if interior.
+type DNAMField struct{ Value string }
+
+func (t *DNAMField) Tag() esm.SubrecordTag { return DNAM }
+
+// Layout implements esm.Structured.
+func (t *DNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *DNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *DNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode DNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Travel destination (Rotations are in radians).
+const DODT esm.SubrecordTag = "DODT"
+
+// Travel destination (Rotations are in radians).
+type DODTField struct {
+	PosX float32
+	PosY float32
+	PosZ float32
+	RotX float32
+	RotY float32
+	RotZ float32
+}
+
+func (t *DODTField) Tag() esm.SubrecordTag { return DODT }
+
+// Size implements esm.Sized.
+func (t *DODTField) Size() int { return 24 }
+
+// Layout implements esm.Structured.
+func (t *DODTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "PosX", Type: "f32", Offset: 0, Size: 4},
+		{Name: "PosY", Type: "f32", Offset: 4, Size: 4},
+		{Name: "PosZ", Type: "f32", Offset: 8, Size: 4},
+		{Name: "RotX", Type: "f32", Offset: 12, Size: 4},
+		{Name: "RotY", Type: "f32", Offset: 16, Size: 4},
+		{Name: "RotZ", Type: "f32", Offset: 20, Size: 4},
+	}
+}
+
+func (s *DODTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 24 {
+		return fmt.Errorf("DODT must be 24 bytes, got %d", len(sub.Data))
+	}
+	s.PosX = util.BytesToFloat32(sub.Data[0:4])
+	s.PosY = util.BytesToFloat32(sub.Data[4:8])
+	s.PosZ = util.BytesToFloat32(sub.Data[8:12])
+	s.RotX = util.BytesToFloat32(sub.Data[12:16])
+	s.RotY = util.BytesToFloat32(sub.Data[16:20])
+	s.RotZ = util.BytesToFloat32(sub.Data[20:24])
+	return nil
+}
+
+func (s *DODTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.PosX); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.PosY); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.PosZ); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.RotX); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.RotY); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.RotZ); err != nil {
+		return nil, err
+	}
+
+	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
+}
+
+// AI data.
+const AIDT esm.SubrecordTag = "AIDT"
+
+// AI data.
+type AIDTField struct {
+	Hello    uint16
+	Fight    uint8
+	Flee     uint8
+	Alarm    uint8
+	Unknown  [3]uint8
+	Services Services
+}
+
+func (t *AIDTField) Tag() esm.SubrecordTag { return AIDT }
+
+// Size implements esm.Sized.
+func (t *AIDTField) Size() int { return 12 }
+
+// Layout implements esm.Structured.
+func (t *AIDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Hello", Type: "u16", Offset: 0, Size: 2},
+		{Name: "Fight", Type: "u8", Offset: 2, Size: 1},
+		{Name: "Flee", Type: "u8", Offset: 3, Size: 1},
+		{Name: "Alarm", Type: "u8", Offset: 4, Size: 1},
+		{Name: "Unknown", Type: "u8", Offset: 5, Size: 3, Count: 3},
+		{Name: "Services", Type: "u32", Offset: 8, Size: 4},
+	}
+}
+
+func (s *AIDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 12 {
+		return fmt.Errorf("AIDT must be 12 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Hello = binary.LittleEndian.Uint16(d[0:2])
+	s.Fight = d[2]
+	s.Flee = d[3]
+	s.Alarm = d[4]
+	for i := range s.Unknown {
+		s.Unknown[i] = d[5+i]
+	}
+	s.Services = Services(binary.LittleEndian.Uint32(d[8:12]))
+	return nil
+}
+
+func (s *AIDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 12)
+	binary.LittleEndian.PutUint16(d[0:2], uint16(s.Hello))
+	d[2] = byte(s.Fight)
+	d[3] = byte(s.Flee)
+	d[4] = byte(s.Alarm)
+	for i := range s.Unknown {
+		d[5+i] = byte(s.Unknown[i])
+	}
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.Services))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// A spell the actor knows.
+const NPCS esm.SubrecordTag = "NPCS"
+
+// A spell the actor knows.
+type NPCSField struct {
+	// Spell ID.
+	Spell string
+	// Junk holds the raw bytes of fixed-length strings that had data after
+	// their terminator, by field name, so they survive a round trip.
+	Junk map[string][]byte
+}
+
+func (t *NPCSField) Tag() esm.SubrecordTag { return NPCS }
+
+// Size implements esm.Sized.
+func (t *NPCSField) Size() int { return 32 }
+
+// Layout implements esm.Structured.
+func (t *NPCSField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Spell", Type: "z", Offset: 0, Size: 32},
+	}
+}
+
+func (s *NPCSField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 32 {
+		return fmt.Errorf("NPCS must be 32 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Junk = nil
+	s.Spell = util.ReadFixedString(d[0:32], &s.Junk, "Spell")
+	return nil
+}
+
+func (s *NPCSField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 32)
+	if err := util.PutFixedString(d[0:32], s.Spell, s.Junk, "Spell"); err != nil {
+		return nil, fmt.Errorf("NPCS.Spell: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// An inventory item.
+const NPCO esm.SubrecordTag = "NPCO"
+
+// An inventory item.
+type NPCOField struct {
+	// Negative counts restock.
+	Count int32
+	// Item ID.
+	Item string
+	// Junk holds the raw bytes of fixed-length strings that had data after
+	// their terminator, by field name, so they survive a round trip.
+	Junk map[string][]byte
+}
+
+func (t *NPCOField) Tag() esm.SubrecordTag { return NPCO }
+
+// Size implements esm.Sized.
+func (t *NPCOField) Size() int { return 36 }
+
+// Layout implements esm.Structured.
+func (t *NPCOField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Count", Type: "i32", Offset: 0, Size: 4},
+		{Name: "Item", Type: "z", Offset: 4, Size: 32},
+	}
+}
+
+func (s *NPCOField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 36 {
+		return fmt.Errorf("NPCO must be 36 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Junk = nil
+	s.Count = int32(binary.LittleEndian.Uint32(d[0:4]))
+	s.Item = util.ReadFixedString(d[4:36], &s.Junk, "Item")
+	return nil
+}
+
+func (s *NPCOField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 36)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Count))
+	if err := util.PutFixedString(d[4:36], s.Item, s.Junk, "Item"); err != nil {
+		return nil, fmt.Errorf("NPCO.Item: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Services are what an NPC or creature buys, sells and offers, from AIDT.
+type Services uint32
+
+const (
+	WeaponsService     Services = 0x01
+	ArmorService       Services = 0x02
+	ClothingService    Services = 0x04
+	BooksService       Services = 0x08
+	IngredientsService Services = 0x10
+	PicksService       Services = 0x20
+	ProbesService      Services = 0x40
+	LightsService      Services = 0x80
+	ApparatusService   Services = 0x100
+	RepairItemsService Services = 0x200
+	MiscService        Services = 0x400
+	SpellsService      Services = 0x800
+	MagicItemsService  Services = 0x1000
+	PotionsService     Services = 0x2000
+	TrainingService    Services = 0x4000
+	SpellmakingService Services = 0x8000
+	EnchantingService  Services = 0x10000
+	RepairService      Services = 0x20000
+)
+
+// servicesNames lists the named bits of Services, in order.
+var servicesNames = []struct {
+	flag Services
+	name string
+}{
+	{WeaponsService, "Weapons"},
+	{ArmorService, "Armor"},
+	{ClothingService, "Clothing"},
+	{BooksService, "Books"},
+	{IngredientsService, "Ingredients"},
+	{PicksService, "Picks"},
+	{ProbesService, "Probes"},
+	{LightsService, "Lights"},
+	{ApparatusService, "Apparatus"},
+	{RepairItemsService, "RepairItems"},
+	{MiscService, "Misc"},
+	{SpellsService, "Spells"},
+	{MagicItemsService, "MagicItems"},
+	{PotionsService, "Potions"},
+	{TrainingService, "Training"},
+	{SpellmakingService, "Spellmaking"},
+	{EnchantingService, "Enchanting"},
+	{RepairService, "Repair"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f Services) Has(flag Services) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *Services) Set(flag Services, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f Services) Names() []string {
+	names := []string{}
+	for _, n := range servicesNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f Services) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseServices combines bit names, as returned by Names, into a Services.
+// Numbers are accepted too.
+func ParseServices(names []string) (Services, error) {
+	var f Services
+next:
+	for _, name := range names {
+		for _, n := range servicesNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown Services %q", name)
+		}
+		f |= Services(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f Services) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *Services) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = Services(v)
+		return nil
+	}
+	v, err := ParseServices(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f Services) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *Services) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = Services(v)
+		return nil
+	}
+	v, err := ParseServices(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
@@ -0,0 +1,130 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package actor
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("AIDT", func(t *testing.T) {
+		for range 32 {
+			s := &AIDTField{}
+			s.Hello = uint16(r.Uint64())
+			s.Fight = uint8(r.Uint64())
+			s.Flee = uint8(r.Uint64())
+			s.Alarm = uint8(r.Uint64())
+			for i := range s.Unknown {
+				s.Unknown[i] = uint8(r.Uint64())
+			}
+			s.Services = Services(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("AI_A", func(t *testing.T) {
+		for range 32 {
+			s := &AI_AField{}
+			s.Target = gentest.String(r, 32)
+			s.ShouldRepeat = uint8(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("AI_E", func(t *testing.T) {
+		for range 32 {
+			s := &AI_EField{}
+			s.X = gentest.Float32(r)
+			s.Y = gentest.Float32(r)
+			s.Z = gentest.Float32(r)
+			s.Duration = int16(r.Uint64())
+			s.Target = gentest.String(r, 32)
+			s.ShouldRepeat = uint8(r.Uint64())
+			s.Padding = uint8(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("AI_F", func(t *testing.T) {
+		for range 32 {
+			s := &AI_FField{}
+			s.X = gentest.Float32(r)
+			s.Y = gentest.Float32(r)
+			s.Z = gentest.Float32(r)
+			s.Duration = int16(r.Uint64())
+			s.Target = gentest.String(r, 32)
+			s.ShouldRepeat = uint8(r.Uint64())
+			s.Padding = uint8(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("AI_T", func(t *testing.T) {
+		for range 32 {
+			s := &AI_TField{}
+			s.X = gentest.Float32(r)
+			s.Y = gentest.Float32(r)
+			s.Z = gentest.Float32(r)
+			s.ShouldRepeat = uint8(r.Uint64())
+			for i := range s.Padding {
+				s.Padding[i] = uint8(r.Uint64())
+			}
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("AI_W", func(t *testing.T) {
+		for range 32 {
+			s := &AI_WField{}
+			s.Distance = int16(r.Uint64())
+			s.Duration = int16(r.Uint64())
+			s.TimeOfDay = uint8(r.Uint64())
+			for i := range s.Idle {
+				s.Idle[i] = uint8(r.Uint64())
+			}
+			s.ShouldRepeat = uint8(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("CNDT", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CNDTField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("DNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("DODT", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DODTField{
+				PosX: gentest.Float32(r), PosY: gentest.Float32(r), PosZ: gentest.Float32(r),
+				RotX: gentest.Float32(r), RotY: gentest.Float32(r), RotZ: gentest.Float32(r),
+			})
+		}
+	})
+	t.Run("NPCO", func(t *testing.T) {
+		for range 32 {
+			s := &NPCOField{}
+			s.Count = int32(r.Uint64())
+			s.Item = gentest.String(r, 32)
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("NPCS", func(t *testing.T) {
+		for range 32 {
+			s := &NPCSField{}
+			s.Spell = gentest.String(r, 32)
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("Services", func(t *testing.T) {
+		for range 32 {
+			want := Services(r.Uint64())
+			got, err := ParseServices(want.Names())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+}
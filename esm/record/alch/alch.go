@@ -0,0 +1,32 @@
+// ALCH records contain potions.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package alch
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+)
+
+// ALCH handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/ALCH
+const ALCH esm.RecordTag = "ALCH"
+
+func init() {
+	esm.RegisterRecord(ALCH, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		p, err := ParsePotion(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	esm.RegisterSubrecords(ALCH,
+		&NAMEField{},
+		&MODLField{},
+		&TEXTField{},
+		&SCRIField{},
+		&FNAMField{},
+		&ALDTField{},
+		&magic.ENAMField{},
+	)
+}
@@ -0,0 +1,146 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package alch
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+)
+
+// PotionRecord is a potion.
+type PotionRecord struct {
+	NAME    *NAMEField
+	MODL    *MODLField
+	TEXT    *TEXTField
+	SCRI    *SCRIField
+	FNAM    *FNAMField
+	ALDT    *ALDTField
+	Effects []*magic.ENAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// potionRecordFields lists the tag that starts each field of PotionRecord.
+var potionRecordFields = []esm.SubrecordTag{NAME, MODL, TEXT, SCRI, FNAM, ALDT, magic.ENAM}
+
+func (r *PotionRecord) Tag() esm.RecordTag { return ALCH }
+
+func (r *PotionRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.TEXT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ALDT); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Effects {
+		if out, err = esm.AppendMarshalled(out, f); err != nil {
+			return nil, err
+		}
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParsePotion builds a PotionRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParsePotion(rec *esm.Record, opts ...esm.ParseOption) (*PotionRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != ALCH {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parsePotionRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parsePotionRecord parses the PotionRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parsePotionRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*PotionRecord, int, error) {
+	r := &PotionRecord{
+		Effects: []*magic.ENAMField{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(potionRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.TEXT != nil {
+				f.Keep(i)
+				break
+			}
+			r.TEXT, err = esm.ParseField[TEXTField](f, i)
+		case 3:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 4:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 5:
+			if r.ALDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.ALDT, err = esm.ParseField[ALDTField](f, i)
+		case 6:
+			var v *magic.ENAMField
+			if v, err = esm.ParseField[magic.ENAMField](f, i); v != nil {
+				r.Effects = append(r.Effects, v)
+			}
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,276 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package alch
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Potion data.
+const ALDT esm.SubrecordTag = "ALDT"
+
+// Potion data.
+type ALDTField struct {
+	Weight float32
+	Value  int32
+	// 1 if the game calculates the value from the effects.
+	Autocalc int32
+}
+
+func (t *ALDTField) Tag() esm.SubrecordTag { return ALDT }
+
+// Size implements esm.Sized.
+func (t *ALDTField) Size() int { return 12 }
+
+// Layout implements esm.Structured.
+func (t *ALDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Weight", Type: "f32", Offset: 0, Size: 4},
+		{Name: "Value", Type: "i32", Offset: 4, Size: 4},
+		{Name: "Autocalc", Type: "i32", Offset: 8, Size: 4},
+	}
+}
+
+func (s *ALDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 12 {
+		return fmt.Errorf("ALDT must be 12 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Weight = math.Float32frombits(binary.LittleEndian.Uint32(d[0:4]))
+	s.Value = int32(binary.LittleEndian.Uint32(d[4:8]))
+	s.Autocalc = int32(binary.LittleEndian.Uint32(d[8:12]))
+	return nil
+}
+
+func (s *ALDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 12)
+	binary.LittleEndian.PutUint32(d[0:4], math.Float32bits(float32(s.Weight)))
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.Value))
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.Autocalc))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Potion name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Potion name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Inventory icon filename.
+const TEXT esm.SubrecordTag = "TEXT"
+
+// Inventory icon filename.
+type TEXTField struct{ Value string }
+
+func (t *TEXTField) Tag() esm.SubrecordTag { return TEXT }
+
+// Layout implements esm.Structured.
+func (t *TEXTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *TEXTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *TEXTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode TEXT: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Potion ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Potion ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
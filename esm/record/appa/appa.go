@@ -0,0 +1,28 @@
+// APPA records contain alchemy apparatus.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package appa
+
+import "github.com/ernmw/omwpacker/esm"
+
+// APPA handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/APPA
+const APPA esm.RecordTag = "APPA"
+
+func init() {
+	esm.RegisterRecord(APPA, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		a, err := ParseApparatus(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return a, nil
+	})
+	esm.RegisterSubrecords(APPA,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&SCRIField{},
+		&AADTField{},
+		&ITEXField{},
+	)
+}
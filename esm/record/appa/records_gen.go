@@ -0,0 +1,132 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package appa
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// ApparatusRecord is an alchemy apparatus.
+type ApparatusRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	SCRI *SCRIField
+	AADT *AADTField
+	ITEX *ITEXField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// apparatusRecordFields lists the tag that starts each field of ApparatusRecord.
+var apparatusRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, SCRI, AADT, ITEX}
+
+func (r *ApparatusRecord) Tag() esm.RecordTag { return APPA }
+
+func (r *ApparatusRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.AADT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ITEX); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseApparatus builds a ApparatusRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseApparatus(rec *esm.Record, opts ...esm.ParseOption) (*ApparatusRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != APPA {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseApparatusRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseApparatusRecord parses the ApparatusRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseApparatusRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*ApparatusRecord, int, error) {
+	r := &ApparatusRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(apparatusRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 4:
+			if r.AADT != nil {
+				f.Keep(i)
+				break
+			}
+			r.AADT, err = esm.ParseField[AADTField](f, i)
+		case 5:
+			if r.ITEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.ITEX, err = esm.ParseField[ITEXField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,333 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package appa
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Apparatus data.
+const AADT esm.SubrecordTag = "AADT"
+
+// Apparatus data.
+type AADTField struct {
+	Kind    Kind
+	Quality float32
+	Weight  float32
+	Value   int32
+}
+
+func (t *AADTField) Tag() esm.SubrecordTag { return AADT }
+
+// Size implements esm.Sized.
+func (t *AADTField) Size() int { return 16 }
+
+// Layout implements esm.Structured.
+func (t *AADTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Kind", Type: "u32", Offset: 0, Size: 4},
+		{Name: "Quality", Type: "f32", Offset: 4, Size: 4},
+		{Name: "Weight", Type: "f32", Offset: 8, Size: 4},
+		{Name: "Value", Type: "i32", Offset: 12, Size: 4},
+	}
+}
+
+func (s *AADTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 16 {
+		return fmt.Errorf("AADT must be 16 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Kind = Kind(binary.LittleEndian.Uint32(d[0:4]))
+	s.Quality = math.Float32frombits(binary.LittleEndian.Uint32(d[4:8]))
+	s.Weight = math.Float32frombits(binary.LittleEndian.Uint32(d[8:12]))
+	s.Value = int32(binary.LittleEndian.Uint32(d[12:16]))
+	return nil
+}
+
+func (s *AADTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 16)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Kind))
+	binary.LittleEndian.PutUint32(d[4:8], math.Float32bits(float32(s.Quality)))
+	binary.LittleEndian.PutUint32(d[8:12], math.Float32bits(float32(s.Weight)))
+	binary.LittleEndian.PutUint32(d[12:16], uint32(s.Value))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Apparatus name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Apparatus name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Apparatus ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Apparatus ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Kind is the kind of apparatus, from AADT.
+type Kind uint32
+
+const (
+	MortarPestleKind Kind = 0
+	AlembicKind      Kind = 1
+	CalcinatorKind   Kind = 2
+	RetortKind       Kind = 3
+)
+
+func (e Kind) String() string {
+	switch e {
+	case MortarPestleKind:
+		return "MortarPestle"
+	case AlembicKind:
+		return "Alembic"
+	case CalcinatorKind:
+		return "Calcinator"
+	case RetortKind:
+		return "Retort"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "MortarPestle":
+		*e = MortarPestleKind
+		return nil
+	case "Alembic":
+		*e = AlembicKind
+		return nil
+	case "Calcinator":
+		*e = CalcinatorKind
+		return nil
+	case "Retort":
+		*e = RetortKind
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*4)
+	if err != nil {
+		return fmt.Errorf("unknown Kind %q", text)
+	}
+	*e = Kind(v)
+	return nil
+}
+
+// Inventory icon filename.
+const ITEX esm.SubrecordTag = "ITEX"
+
+// Inventory icon filename.
+type ITEXField struct{ Value string }
+
+func (t *ITEXField) Tag() esm.SubrecordTag { return ITEX }
+
+// Layout implements esm.Structured.
+func (t *ITEXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ITEXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ITEXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ITEX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
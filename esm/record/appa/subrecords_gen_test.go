@@ -0,0 +1,60 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package appa
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("AADT", func(t *testing.T) {
+		for range 32 {
+			s := &AADTField{}
+			s.Kind = Kind(r.Uint64())
+			s.Quality = gentest.Float32(r)
+			s.Weight = gentest.Float32(r)
+			s.Value = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("ITEX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ITEXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Kind", func(t *testing.T) {
+		for range 32 {
+			want := Kind(r.Uint64())
+			var got Kind
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("MODL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MODLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SCRI", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCRIField{Value: gentest.String(r, 64)})
+		}
+	})
+}
@@ -0,0 +1,32 @@
+// ARMO records contain armor.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package armo
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/item"
+)
+
+// ARMO handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/ARMO
+const ARMO esm.RecordTag = "ARMO"
+
+func init() {
+	esm.RegisterRecord(ARMO, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		a, err := ParseArmor(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return a, nil
+	})
+	esm.RegisterSubrecords(ARMO, append([]esm.ParsedSubrecord{
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&SCRIField{},
+		&AODTField{},
+		&ITEXField{},
+		&ENAMField{},
+	}, item.Subrecords()...)...)
+}
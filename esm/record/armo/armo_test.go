@@ -0,0 +1,37 @@
+package armo
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/ernmw/omwpacker/esm/record/item"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParts(t *testing.T) {
+	rec := &esm.Record{Tag: ARMO, Subrecords: gentest.Marshal(t,
+		&NAMEField{Value: "netch_leather_boots"},
+		&AODTField{Kind: BootsKind, Rating: 5},
+		&item.INDXField{Value: item.RightFootPart},
+		&item.CNAMField{Value: "a_netch_foot_f"},
+		&item.INDXField{Value: item.LeftFootPart},
+		&item.BNAMField{Value: "a_netch_foot"},
+		&item.INDXField{Value: item.RightAnklePart},
+		&ENAMField{Value: "boots_en"},
+	)}
+
+	a, err := ParseArmor(rec)
+	require.NoError(t, err)
+	require.Equal(t, []*item.Part{
+		{INDX: &item.INDXField{Value: item.RightFootPart}, CNAM: &item.CNAMField{Value: "a_netch_foot_f"}},
+		{INDX: &item.INDXField{Value: item.LeftFootPart}, BNAM: &item.BNAMField{Value: "a_netch_foot"}},
+		{INDX: &item.INDXField{Value: item.RightAnklePart}},
+	}, a.Parts)
+	require.Equal(t, "boots_en", a.ENAM.Value)
+	require.Equal(t, "Boots", a.AODT.Kind.String())
+
+	out, err := a.OrderedRecords()
+	require.NoError(t, err)
+	require.Equal(t, rec.Subrecords, out)
+}
@@ -0,0 +1,157 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package armo
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/item"
+)
+
+// ArmorRecord is a piece of armor.
+type ArmorRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	SCRI *SCRIField
+	AODT *AODTField
+	ITEX *ITEXField
+	// Body parts the item covers.
+	Parts []*item.Part
+	ENAM  *ENAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// armorRecordFields lists the tag that starts each field of ArmorRecord.
+var armorRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, SCRI, AODT, ITEX, item.INDX, ENAM}
+
+func (r *ArmorRecord) Tag() esm.RecordTag { return ARMO }
+
+func (r *ArmorRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.AODT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ITEX); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Parts {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	if out, err = esm.AppendMarshalled(out, r.ENAM); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseArmor builds a ArmorRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseArmor(rec *esm.Record, opts ...esm.ParseOption) (*ArmorRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != ARMO {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseArmorRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseArmorRecord parses the ArmorRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseArmorRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*ArmorRecord, int, error) {
+	r := &ArmorRecord{
+		Parts: []*item.Part{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(armorRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 4:
+			if r.AODT != nil {
+				f.Keep(i)
+				break
+			}
+			r.AODT, err = esm.ParseField[AODTField](f, i)
+		case 5:
+			if r.ITEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.ITEX, err = esm.ParseField[ITEXField](f, i)
+		case 6:
+			var g *item.Part
+			if g, consumed, err = item.ParsePart(rec, i, o); err == nil {
+				r.Parts = append(r.Parts, g)
+			}
+		case 7:
+			if r.ENAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.ENAM, err = esm.ParseField[ENAMField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,427 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package armo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Enchantment ID.
+const ENAM esm.SubrecordTag = "ENAM"
+
+// Enchantment ID.
+type ENAMField struct{ Value string }
+
+func (t *ENAMField) Tag() esm.SubrecordTag { return ENAM }
+
+// Layout implements esm.Structured.
+func (t *ENAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ENAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ENAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ENAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Armor data.
+const AODT esm.SubrecordTag = "AODT"
+
+// Armor data.
+type AODTField struct {
+	Kind          Kind
+	Weight        float32
+	Value         int32
+	Health        int32
+	EnchantPoints int32
+	// Armor rating.
+	Rating int32
+}
+
+func (t *AODTField) Tag() esm.SubrecordTag { return AODT }
+
+// Size implements esm.Sized.
+func (t *AODTField) Size() int { return 24 }
+
+// Layout implements esm.Structured.
+func (t *AODTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Kind", Type: "u32", Offset: 0, Size: 4},
+		{Name: "Weight", Type: "f32", Offset: 4, Size: 4},
+		{Name: "Value", Type: "i32", Offset: 8, Size: 4},
+		{Name: "Health", Type: "i32", Offset: 12, Size: 4},
+		{Name: "EnchantPoints", Type: "i32", Offset: 16, Size: 4},
+		{Name: "Rating", Type: "i32", Offset: 20, Size: 4},
+	}
+}
+
+func (s *AODTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 24 {
+		return fmt.Errorf("AODT must be 24 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Kind = Kind(binary.LittleEndian.Uint32(d[0:4]))
+	s.Weight = math.Float32frombits(binary.LittleEndian.Uint32(d[4:8]))
+	s.Value = int32(binary.LittleEndian.Uint32(d[8:12]))
+	s.Health = int32(binary.LittleEndian.Uint32(d[12:16]))
+	s.EnchantPoints = int32(binary.LittleEndian.Uint32(d[16:20]))
+	s.Rating = int32(binary.LittleEndian.Uint32(d[20:24]))
+	return nil
+}
+
+func (s *AODTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 24)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Kind))
+	binary.LittleEndian.PutUint32(d[4:8], math.Float32bits(float32(s.Weight)))
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.Value))
+	binary.LittleEndian.PutUint32(d[12:16], uint32(s.Health))
+	binary.LittleEndian.PutUint32(d[16:20], uint32(s.EnchantPoints))
+	binary.LittleEndian.PutUint32(d[20:24], uint32(s.Rating))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Armor name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Armor name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Armor ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Armor ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Kind is the kind of armor, from AODT.
+type Kind uint32
+
+const (
+	HelmetKind        Kind = 0
+	CuirassKind       Kind = 1
+	LeftPauldronKind  Kind = 2
+	RightPauldronKind Kind = 3
+	GreavesKind       Kind = 4
+	BootsKind         Kind = 5
+	LeftGauntletKind  Kind = 6
+	RightGauntletKind Kind = 7
+	ShieldKind        Kind = 8
+	LeftBracerKind    Kind = 9
+	RightBracerKind   Kind = 10
+)
+
+func (e Kind) String() string {
+	switch e {
+	case HelmetKind:
+		return "Helmet"
+	case CuirassKind:
+		return "Cuirass"
+	case LeftPauldronKind:
+		return "LeftPauldron"
+	case RightPauldronKind:
+		return "RightPauldron"
+	case GreavesKind:
+		return "Greaves"
+	case BootsKind:
+		return "Boots"
+	case LeftGauntletKind:
+		return "LeftGauntlet"
+	case RightGauntletKind:
+		return "RightGauntlet"
+	case ShieldKind:
+		return "Shield"
+	case LeftBracerKind:
+		return "LeftBracer"
+	case RightBracerKind:
+		return "RightBracer"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Helmet":
+		*e = HelmetKind
+		return nil
+	case "Cuirass":
+		*e = CuirassKind
+		return nil
+	case "LeftPauldron":
+		*e = LeftPauldronKind
+		return nil
+	case "RightPauldron":
+		*e = RightPauldronKind
+		return nil
+	case "Greaves":
+		*e = GreavesKind
+		return nil
+	case "Boots":
+		*e = BootsKind
+		return nil
+	case "LeftGauntlet":
+		*e = LeftGauntletKind
+		return nil
+	case "RightGauntlet":
+		*e = RightGauntletKind
+		return nil
+	case "Shield":
+		*e = ShieldKind
+		return nil
+	case "LeftBracer":
+		*e = LeftBracerKind
+		return nil
+	case "RightBracer":
+		*e = RightBracerKind
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*4)
+	if err != nil {
+		return fmt.Errorf("unknown Kind %q", text)
+	}
+	*e = Kind(v)
+	return nil
+}
+
+// Inventory icon filename.
+const ITEX esm.SubrecordTag = "ITEX"
+
+// Inventory icon filename.
+type ITEXField struct{ Value string }
+
+func (t *ITEXField) Tag() esm.SubrecordTag { return ITEX }
+
+// Layout implements esm.Structured.
+func (t *ITEXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ITEXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ITEXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ITEX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,30 @@
+// BOOK records contain books and scrolls.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package book
+
+import "github.com/ernmw/omwpacker/esm"
+
+// BOOK handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/BOOK
+const BOOK esm.RecordTag = "BOOK"
+
+func init() {
+	esm.RegisterRecord(BOOK, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		b, err := ParseBook(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return b, nil
+	})
+	esm.RegisterSubrecords(BOOK,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&BKDTField{},
+		&SCRIField{},
+		&ITEXField{},
+		&TEXTField{},
+		&ENAMField{},
+	)
+}
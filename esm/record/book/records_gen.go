@@ -0,0 +1,152 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package book
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// BookRecord is a book or scroll.
+type BookRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	BKDT *BKDTField
+	SCRI *SCRIField
+	ITEX *ITEXField
+	TEXT *TEXTField
+	ENAM *ENAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// bookRecordFields lists the tag that starts each field of BookRecord.
+var bookRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, BKDT, SCRI, ITEX, TEXT, ENAM}
+
+func (r *BookRecord) Tag() esm.RecordTag { return BOOK }
+
+func (r *BookRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.BKDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ITEX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.TEXT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ENAM); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseBook builds a BookRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseBook(rec *esm.Record, opts ...esm.ParseOption) (*BookRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != BOOK {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseBookRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseBookRecord parses the BookRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseBookRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*BookRecord, int, error) {
+	r := &BookRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(bookRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.BKDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.BKDT, err = esm.ParseField[BKDTField](f, i)
+		case 4:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 5:
+			if r.ITEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.ITEX, err = esm.ParseField[ITEXField](f, i)
+		case 6:
+			if r.TEXT != nil {
+				f.Keep(i)
+				break
+			}
+			r.TEXT, err = esm.ParseField[TEXTField](f, i)
+		case 7:
+			if r.ENAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.ENAM, err = esm.ParseField[ENAMField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,365 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package book
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Inventory icon filename.
+const ITEX esm.SubrecordTag = "ITEX"
+
+// Inventory icon filename.
+type ITEXField struct{ Value string }
+
+func (t *ITEXField) Tag() esm.SubrecordTag { return ITEX }
+
+// Layout implements esm.Structured.
+func (t *ITEXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ITEXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ITEXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ITEX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Book data.
+const BKDT esm.SubrecordTag = "BKDT"
+
+// Book data.
+type BKDTField struct {
+	Weight float32
+	Value  int32
+	// 1 for scrolls, which close when read.
+	Scroll int32
+	// Skill that reading the book raises, or -1.
+	Skill         int32
+	EnchantPoints int32
+}
+
+func (t *BKDTField) Tag() esm.SubrecordTag { return BKDT }
+
+// Size implements esm.Sized.
+func (t *BKDTField) Size() int { return 20 }
+
+// Layout implements esm.Structured.
+func (t *BKDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Weight", Type: "f32", Offset: 0, Size: 4},
+		{Name: "Value", Type: "i32", Offset: 4, Size: 4},
+		{Name: "Scroll", Type: "i32", Offset: 8, Size: 4},
+		{Name: "Skill", Type: "i32", Offset: 12, Size: 4},
+		{Name: "EnchantPoints", Type: "i32", Offset: 16, Size: 4},
+	}
+}
+
+func (s *BKDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 20 {
+		return fmt.Errorf("BKDT must be 20 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Weight = math.Float32frombits(binary.LittleEndian.Uint32(d[0:4]))
+	s.Value = int32(binary.LittleEndian.Uint32(d[4:8]))
+	s.Scroll = int32(binary.LittleEndian.Uint32(d[8:12]))
+	s.Skill = int32(binary.LittleEndian.Uint32(d[12:16]))
+	s.EnchantPoints = int32(binary.LittleEndian.Uint32(d[16:20]))
+	return nil
+}
+
+func (s *BKDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 20)
+	binary.LittleEndian.PutUint32(d[0:4], math.Float32bits(float32(s.Weight)))
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.Value))
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.Scroll))
+	binary.LittleEndian.PutUint32(d[12:16], uint32(s.Skill))
+	binary.LittleEndian.PutUint32(d[16:20], uint32(s.EnchantPoints))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Book text, in HTML.
+const TEXT esm.SubrecordTag = "TEXT"
+
+// Book text, in HTML.
+type TEXTField struct{ Value string }
+
+func (t *TEXTField) Tag() esm.SubrecordTag { return TEXT }
+
+// Layout implements esm.Structured.
+func (t *TEXTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *TEXTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	s.Value = util.DecodeString(sub.Data)
+
+	return nil
+}
+
+func (s *TEXTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode TEXT: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: raw}, nil
+}
+
+// Enchantment ID.
+const ENAM esm.SubrecordTag = "ENAM"
+
+// Enchantment ID.
+type ENAMField struct{ Value string }
+
+func (t *ENAMField) Tag() esm.SubrecordTag { return ENAM }
+
+// Layout implements esm.Structured.
+func (t *ENAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ENAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ENAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ENAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Book name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Book name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Book ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Book ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,60 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package book
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("BKDT", func(t *testing.T) {
+		for range 32 {
+			s := &BKDTField{}
+			s.Weight = gentest.Float32(r)
+			s.Value = int32(r.Uint64())
+			s.Scroll = int32(r.Uint64())
+			s.Skill = int32(r.Uint64())
+			s.EnchantPoints = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("ENAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ENAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("ITEX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ITEXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("MODL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MODLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SCRI", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCRIField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("TEXT", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &TEXTField{Value: gentest.String(r, 64)})
+		}
+	})
+}
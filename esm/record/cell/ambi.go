@@ -21,12 +21,15 @@ type AMBIField struct {
 
 func (s *AMBIField) Tag() esm.SubrecordTag { return AMBI }
 
+// Size implements esm.Sized.
+func (s *AMBIField) Size() int { return 16 }
+
 func (s *AMBIField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	if len(sub.Data) < 16 {
-		return fmt.Errorf("CELL.AMBI too short: %d < 16", len(sub.Data))
+	if len(sub.Data) != 16 {
+		return fmt.Errorf("CELL.AMBI must be 16 bytes, got %d", len(sub.Data))
 	}
 	copy(s.AmbientColor[:], sub.Data[0:3]) // 4 is padding
 	copy(s.Sunlight[:], sub.Data[4:7])     // 8 is padding
@@ -0,0 +1,17 @@
+package cell
+
+import "github.com/ernmw/omwpacker/esm"
+
+// ObjectTags lists the tags of the records a FormReference can place.
+var ObjectTags = []esm.RecordTag{
+	"ACTI", "ALCH", "APPA", "ARMO", "BOOK", "CLOT", "CONT", "CREA", "DOOR", "INGR",
+	"LEVC", "LEVI", "LIGH", "LOCK", "MISC", "NPC_", "PROB", "REPA", "STAT", "WEAP",
+}
+
+// Base returns the winning record of the object r places.
+func (r *FormReference) Base(idx *esm.LoadOrderIndex) (*esm.Record, bool) {
+	if r == nil || r.NAME == nil {
+		return nil, false
+	}
+	return idx.Find(r.NAME.Value, ObjectTags...)
+}
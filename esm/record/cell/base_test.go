@@ -0,0 +1,40 @@
+package cell
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase(t *testing.T) {
+	object := func(tag esm.RecordTag, name string) *esm.Record {
+		return &esm.Record{Tag: tag, Subrecords: []*esm.Subrecord{{Tag: NAME, Data: []byte(name + "\x00")}}}
+	}
+	base, err := esm.NewPluginIndex("base.esm", []*esm.Record{
+		object("STAT", "furn_de_chair_01"),
+		object("DOOR", "ex_common_door_01"),
+		object("GLOB", "chair"),
+	})
+	require.NoError(t, err)
+	patch, err := esm.NewPluginIndex("patch.esp", []*esm.Record{
+		object("DOOR", "EX_COMMON_DOOR_01"),
+	})
+	require.NoError(t, err)
+	idx := esm.NewLoadOrderIndex(base, patch)
+
+	chair := &FormReference{NAME: &NAMEField{Value: "Furn_De_Chair_01"}}
+	rec, ok := chair.Base(idx)
+	require.True(t, ok)
+	require.Equal(t, esm.RecordTag("STAT"), rec.Tag)
+
+	door := &FormReference{NAME: &NAMEField{Value: "ex_common_door_01"}}
+	rec, ok = door.Base(idx)
+	require.True(t, ok)
+	require.Equal(t, "EX_COMMON_DOOR_01\x00", string(rec.Subrecords[0].Data))
+
+	_, ok = (&FormReference{NAME: &NAMEField{Value: "chair"}}).Base(idx)
+	require.False(t, ok, "globals aren't objects")
+	_, ok = (&FormReference{}).Base(idx)
+	require.False(t, ok)
+}
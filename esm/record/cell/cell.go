@@ -1,175 +1,65 @@
 // CELL records contain information about cells (both interior and exterior).
 //
 //go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
 package cell
 
-import (
-	"fmt"
-
-	"github.com/ernmw/omwpacker/esm"
-)
+import "github.com/ernmw/omwpacker/esm"
 
 // Cell handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/CELL
 const CELL esm.RecordTag = "CELL"
 
-// CellRecord represents a full CellRecord record composed of subrecords.
-type CellRecord struct {
-	NAME               *NAMEField
-	DATA               *DATAField
-	RGNN               *RGNNField
-	NAM5               *NAM5Field
-	WHGT               *WHGTField
-	AMBI               *AMBIField
-	MovedReferences    []*MoveReference
-	PersistentChildren []*FormReference
-	// Count of temporaray children
-	NAM0              *NAM0Field
-	TemporaryChildren []*FormReference
-}
-
-func (c *CellRecord) OrderedRecords() ([]*esm.Subrecord, error) {
-	if c == nil {
-		return nil, nil
-	}
-	orderedSubrecords := []*esm.Subrecord{}
-	add := func(p esm.ParsedSubrecord) error {
-		if p != nil {
-			subRec, err := p.Marshal()
-			if err != nil {
-				return fmt.Errorf("marshal %q to subrec", p.Tag())
-			}
-			if subRec != nil {
-				orderedSubrecords = append(orderedSubrecords, subRec)
-			}
-		}
-		return nil
-	}
-
-	if err := add(c.NAME); err != nil {
-		return nil, err
-	}
-	if err := add(c.DATA); err != nil {
-		return nil, err
-	}
-	if err := add(c.RGNN); err != nil {
-		return nil, err
-	}
-	if err := add(c.NAM5); err != nil {
-		return nil, err
-	}
-	if err := add(c.WHGT); err != nil {
-		return nil, err
-	}
-	if err := add(c.AMBI); err != nil {
-		return nil, err
-	}
-
-	for _, mr := range c.MovedReferences {
-		if recs, err := mr.OrderedRecords(); err != nil {
-			return nil, err
-		} else {
-			orderedSubrecords = append(orderedSubrecords, recs...)
-		}
-	}
-	for _, fr := range c.PersistentChildren {
-		if recs, err := fr.OrderedRecords(); err != nil {
+func init() {
+	esm.RegisterRecord(CELL, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		c, err := ParseCELL(rec, opts...)
+		if err != nil {
 			return nil, err
-		} else {
-			orderedSubrecords = append(orderedSubrecords, recs...)
-		}
-	}
-
-	// deal with temp children in cell
-	tempChildrenCount := uint32(len(c.TemporaryChildren))
-	if tempChildrenCount > 0 {
-		if c.NAM0 == nil {
-			c.NAM0 = &NAM0Field{}
 		}
-		c.NAM0.Value = uint32(len(c.TemporaryChildren))
-		if err := add(c.NAM0); err != nil {
-			return nil, err
+		return c, nil
+	})
+	// Exterior cells are identified by their grid, interiors by name.
+	esm.RegisterIdentity(CELL, func(rec *esm.Record) (string, error) {
+		data := &DATAField{}
+		if err := rec.UnmarshalFirst(data); err != nil {
+			return "", err
 		}
-		for _, fr := range c.TemporaryChildren {
-			if recs, err := fr.OrderedRecords(); err != nil {
-				return nil, err
-			} else {
-				orderedSubrecords = append(orderedSubrecords, recs...)
-			}
+		if !data.Flags.Has(InteriorFlag) {
+			return esm.GridKey(data.GridX, data.GridY), nil
 		}
-	} else {
-		c.NAM0 = nil
-	}
-
-	return orderedSubrecords, nil
-}
-
-// ParseCELL builds a CELL record from a list of subrecords.
-func ParseCELL(rec *esm.Record) (*CellRecord, error) {
-	if rec == nil {
-		return nil, esm.ErrArgumentNil
-	}
-	if rec.Tag != CELL {
-		return nil, esm.ErrTagMismatch
-	}
-	c := &CellRecord{
-		MovedReferences:    []*MoveReference{},
-		PersistentChildren: []*FormReference{},
-		TemporaryChildren:  []*FormReference{},
-	}
-	for i := 0; i < len(rec.Subrecords); i++ {
-		sub := rec.Subrecords[i]
-		switch sub.Tag {
-		case NAME:
-			c.NAME = &NAMEField{}
-			if err := c.NAME.Unmarshal(sub); err != nil {
-				return nil, err
-			}
-		case DATA:
-			c.DATA = &DATAField{}
-			if err := c.DATA.Unmarshal(sub); err != nil {
-				return nil, err
-			}
-		case RGNN:
-			c.RGNN = &RGNNField{}
-			if err := c.RGNN.Unmarshal(sub); err != nil {
-				return nil, err
-			}
-		case NAM5:
-			c.NAM5 = &NAM5Field{}
-			if err := c.NAM5.Unmarshal(sub); err != nil {
-				return nil, err
-			}
-		case WHGT:
-			c.WHGT = &WHGTField{}
-			if err := c.WHGT.Unmarshal(sub); err != nil {
-				return nil, err
-			}
-		case AMBI:
-			c.AMBI = &AMBIField{}
-			if err := c.AMBI.Unmarshal(sub); err != nil {
-				return nil, err
-			}
-		case MVRF:
-			newMoveRef, consumed, err := ParseMoveRef(rec.Subrecords[i:])
-			if err != nil {
-				return nil, fmt.Errorf("parse form reference: %w", err)
-			}
-			c.MovedReferences = append(c.MovedReferences, newMoveRef)
-			i = i + consumed
-		case FRMR:
-			newFormRef, consumed, err := ParseFormRef(rec.Subrecords[i:])
-			if err != nil {
-				return nil, fmt.Errorf("parse form reference: %w", err)
-			}
-			if c.NAM0 != nil {
-				c.TemporaryChildren = append(c.TemporaryChildren, newFormRef)
-			} else {
-				c.PersistentChildren = append(c.PersistentChildren, newFormRef)
-			}
-			i = i + consumed
-		default:
-			return nil, fmt.Errorf("unknown CELL subrecord %q", sub.Tag)
+		name := &NAMEField{}
+		if err := rec.UnmarshalFirst(name); err != nil {
+			return "", err
 		}
-	}
-	return c, nil
+		return name.Value, nil
+	})
+	// DATAFormReferenceField shares the DATA tag, so only the
+	// cell-level DATA is registered.
+	esm.RegisterSubrecords(CELL,
+		&NAMEField{},
+		&DATAField{},
+		&RGNNField{},
+		&NAM5Field{},
+		&WHGTField{},
+		&AMBIField{},
+		&NAM0Field{},
+		&MVRFField{},
+		&CNDTField{},
+		&FRMRField{},
+		&UNAMField{},
+		&XSCLField{},
+		&ANAMField{},
+		&BNAMField{},
+		&CNAMField{},
+		&INDXField{},
+		&XSOLField{},
+		&XCHGField{},
+		&INTVField{},
+		&NAM9Field{},
+		&DODTField{},
+		&DNAMField{},
+		&FLTVField{},
+		&KNAMField{},
+		&TNAMField{},
+		&ZNAMField{},
+	)
 }
@@ -0,0 +1,60 @@
+package cell
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemporaryChildrenCount(t *testing.T) {
+	ref := func(id uint32, name string) *FormReference {
+		return &FormReference{FRMR: &FRMRField{Value: id}, NAME: &NAMEField{Value: name}}
+	}
+	roundTrip := func(c *CellRecord) (*esm.Record, *CellRecord) {
+		rec, err := esm.Encode(c)
+		require.NoError(t, err)
+		parsed, err := ParseCELL(rec)
+		require.NoError(t, err)
+		return rec, parsed
+	}
+
+	_, c := roundTrip(&CellRecord{
+		NAME:               &NAMEField{Value: "Balmora, Guild of Mages"},
+		DATA:               &DATAField{Flags: 1},
+		PersistentChildren: []*FormReference{ref(1, "ajira")},
+		TemporaryChildren:  []*FormReference{ref(2, "furn_de_chair_01"), ref(3, "light_com_candle_01")},
+	})
+	require.Equal(t, uint32(2), c.NAM0.Value)
+
+	// Editing the parsed children updates the count, without changing the
+	// record being encoded.
+	c.TemporaryChildren = append(c.TemporaryChildren, ref(4, "misc_com_bucket_01"))
+	edited := c
+	_, c = roundTrip(edited)
+	require.Equal(t, uint32(2), edited.NAM0.Value)
+	require.Equal(t, uint32(3), c.NAM0.Value)
+	require.Len(t, c.TemporaryChildren, 3)
+
+	// A count the editor got wrong is kept until the children change.
+	rec, err := esm.Encode(c)
+	require.NoError(t, err)
+	nam0 := slices.IndexFunc(rec.Subrecords, func(s *esm.Subrecord) bool { return s.Tag == NAM0 })
+	rec.Subrecords[nam0].Data = []byte{5, 0, 0, 0}
+	c, err = ParseCELL(rec)
+	require.NoError(t, err)
+	again, err := esm.Encode(c)
+	require.NoError(t, err)
+	require.Equal(t, rec.Subrecords, again.Subrecords)
+	c.TemporaryChildren = c.TemporaryChildren[:2]
+	_, c = roundTrip(c)
+	require.Equal(t, uint32(2), c.NAM0.Value)
+
+	// Without temporary children, NAM0 is dropped.
+	c.TemporaryChildren = nil
+	rec, c = roundTrip(c)
+	require.Nil(t, rec.Find(NAM0))
+	require.Nil(t, c.NAM0)
+	require.Len(t, c.PersistentChildren, 1)
+}
@@ -0,0 +1,621 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package cell
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// CellRecord represents a full CellRecord record composed of subrecords.
+type CellRecord struct {
+	NAME               *NAMEField
+	DATA               *DATAField
+	RGNN               *RGNNField
+	NAM5               *NAM5Field
+	WHGT               *WHGTField
+	AMBI               *AMBIField
+	MovedReferences    []*MoveReference
+	PersistentChildren []*FormReference
+	// Marks the start of temporary children. Encoded as the number of
+	// TemporaryChildren, or left out if there are none. A NAM0 parsed with
+	// another count is encoded as read until TemporaryChildren changes length.
+	NAM0              *NAM0Field
+	TemporaryChildren []*FormReference
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+	// miscountedTemporaryChildren is set if NAM0 was parsed with a
+	// value other than the length of TemporaryChildren, which
+	// readTemporaryChildren holds.
+	miscountedTemporaryChildren bool
+	readTemporaryChildren       int
+}
+
+// cellRecordFields lists the tag that starts each field of CellRecord.
+var cellRecordFields = []esm.SubrecordTag{NAME, DATA, RGNN, NAM5, WHGT, AMBI, MVRF, FRMR, NAM0, FRMR}
+
+func (r *CellRecord) Tag() esm.RecordTag { return CELL }
+
+func (r *CellRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	temporaryChildrenCount := r.NAM0
+	if !r.miscountedTemporaryChildren || len(r.TemporaryChildren) != r.readTemporaryChildren {
+		temporaryChildrenCount = nil
+		if len(r.TemporaryChildren) > 0 {
+			temporaryChildrenCount = &NAM0Field{Value: uint32(len(r.TemporaryChildren))}
+		}
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DATA); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.RGNN); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.NAM5); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.WHGT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.AMBI); err != nil {
+		return nil, err
+	}
+	for _, f := range r.MovedReferences {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range r.PersistentChildren {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	if out, err = esm.AppendMarshalled(out, temporaryChildrenCount); err != nil {
+		return nil, err
+	}
+	for _, f := range r.TemporaryChildren {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseCELL builds a CellRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseCELL(rec *esm.Record, opts ...esm.ParseOption) (*CellRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != CELL {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseCellRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseCellRecord parses the CellRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseCellRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*CellRecord, int, error) {
+	r := &CellRecord{
+		MovedReferences:    []*MoveReference{},
+		PersistentChildren: []*FormReference{},
+		TemporaryChildren:  []*FormReference{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(cellRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.DATA != nil {
+				f.Keep(i)
+				break
+			}
+			r.DATA, err = esm.ParseField[DATAField](f, i)
+		case 2:
+			if r.RGNN != nil {
+				f.Keep(i)
+				break
+			}
+			r.RGNN, err = esm.ParseField[RGNNField](f, i)
+		case 3:
+			if r.NAM5 != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAM5, err = esm.ParseField[NAM5Field](f, i)
+		case 4:
+			if r.WHGT != nil {
+				f.Keep(i)
+				break
+			}
+			r.WHGT, err = esm.ParseField[WHGTField](f, i)
+		case 5:
+			if r.AMBI != nil {
+				f.Keep(i)
+				break
+			}
+			r.AMBI, err = esm.ParseField[AMBIField](f, i)
+		case 6:
+			var g *MoveReference
+			if g, consumed, err = parseMoveReference(rec, i, o); err == nil {
+				r.MovedReferences = append(r.MovedReferences, g)
+			}
+		case 7:
+			var g *FormReference
+			if g, consumed, err = parseFormReference(rec, i, o); err == nil {
+				r.PersistentChildren = append(r.PersistentChildren, g)
+			}
+		case 8:
+			if r.NAM0 != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAM0, err = esm.ParseField[NAM0Field](f, i)
+		case 9:
+			var g *FormReference
+			if g, consumed, err = parseFormReference(rec, i, o); err == nil {
+				r.TemporaryChildren = append(r.TemporaryChildren, g)
+			}
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAM0 != nil && (len(r.TemporaryChildren) == 0 || r.NAM0.Value != uint32(len(r.TemporaryChildren))) {
+		r.miscountedTemporaryChildren = true
+		r.readTemporaryChildren = len(r.TemporaryChildren)
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	if r.DATA == nil {
+		if err := f.Missing(DATA); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// These only appear in mod files when creatures or NPCs are moved from one cell to another; they commonly appear in saved game files as things move around.
+type MoveReference struct {
+	// Reference ID (always the same as the attached FRMR value).
+	// Required.
+	MVRF *MVRFField
+	// Name of the cell the reference was moved to (interior cells only)
+	// zstring
+	// Optional.
+	CNAM *CNAMField
+	// Coordinates of the cell the reference was moved to (exterior cells only)
+	//   int32 - Grid X
+	//   int32 - Grid Y
+	// Optional.
+	CNDT *CNDTField
+	// Reference to the form that was moved.
+	// Optional.
+	Moved *FormReference
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// moveReferenceFields lists the tag that starts each field of MoveReference.
+var moveReferenceFields = []esm.SubrecordTag{MVRF, CNAM, CNDT, FRMR}
+
+func (r *MoveReference) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.MVRF); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendOrdered(out, r.Moved); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseMoveRef parses the MoveReference starting at subs[0] and returns it
+// with the number of subrecords it ate.
+func ParseMoveRef(subs []*esm.Subrecord, opts ...esm.ParseOption) (*MoveReference, int, error) {
+	if subs == nil {
+		return nil, 0, esm.ErrArgumentNil
+	}
+	return parseMoveReference(&esm.Record{Tag: CELL, Subrecords: subs}, 0, esm.NewParseOptions(opts...))
+}
+
+// parseMoveReference parses the MoveReference starting at rec.Subrecords[start].
+// It stops before NAM0, or a tag whose field is already set.
+func parseMoveReference(rec *esm.Record, start int, o *esm.ParseOptions) (*MoveReference, int, error) {
+	r := &MoveReference{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		if tag == NAM0 {
+			break
+		}
+		field := esm.MatchField(moveReferenceFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.MVRF != nil {
+				break fields
+			}
+			r.MVRF, err = esm.ParseField[MVRFField](f, i)
+		case 1:
+			if r.CNAM != nil {
+				break fields
+			}
+			r.CNAM, err = esm.ParseField[CNAMField](f, i)
+		case 2:
+			if r.CNDT != nil {
+				break fields
+			}
+			r.CNDT, err = esm.ParseField[CNDTField](f, i)
+		case 3:
+			if r.Moved != nil {
+				break fields
+			}
+			r.Moved, consumed, err = parseFormReference(rec, i, o)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.MVRF == nil {
+		if err := f.Missing(MVRF); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// References to objects in cells are listed as part of the cell data, each beginning with FRMR and NAME fields, followed by a list of fields specific to the object type.
+type FormReference struct {
+	// Reference ID.
+	// Type: uint32
+	// Required.
+	FRMR *FRMRField
+	// Object ID or "PlayerSaveGame".
+	// zstring
+	// Required.
+	NAME *NAMEField
+	// Reference blocked (value is always 0; present if Blocked is set in the reference's record header, otherwise absent).
+	// uint8
+	// Optional.
+	UNAM *UNAMField
+	// Reference's scale, if applicable and not 1.0.
+	// float32
+	// Optional.
+	XSCL *XSCLField
+	// NPC ID, if applicable (NPC-only).
+	// zstring
+	// Optional, exclusive with BNAM.
+	ANAM *ANAMField
+	// Global variable name
+	// zstring
+	// Optional, exclusive with ANAM.
+	BNAM *BNAMField
+	// Faction ID (not light, NPC, or static)
+	// zstring
+	// Optional, if present then INDX must also exist.
+	CNAM *CNAMField
+	// Faction rank.
+	// uint32
+	// Optional, if present then CNAM must also exist.
+	INDX *INDXField
+	// ID of soul in gem (soul gems only)
+	// zstring
+	// Optional.
+	XSOL *XSOLField
+	// Enchantment charge (charged items with non-zero charges).
+	// float32
+	// Optional.
+	XCHG *XCHGField
+	// Depends on the object type.
+	//   uint32 - health remaining (weapons and armor)
+	//   uint32 - uses remaining (locks, probes, repair items)
+	//   float32 - time remaining (lights)
+	// Optional.
+	INTV *INTVField
+	// Value (in gold)
+	// uint32
+	// Optional.
+	NAM9 *NAM9Field
+	// Cell Travel Destination (Rotations are in radians)
+	//   float32 - Position X
+	//   float32 - Position Y
+	//   float32 - Position Z
+	//   float32 - Rotation X
+	//   float32 - Rotation Y
+	//   float32 - Rotation Z
+	// Optional.
+	DODT *DODTField
+	// Cell name for previous DODT, if interior.
+	// zstring
+	// Optional, must accompany DODT if present.
+	DNAM *DNAMField
+	// Lock difficulty
+	// uint32
+	// Optional.
+	FLTV *FLTVField
+	// Key name
+	// zstring
+	// Optional.
+	KNAM *KNAMField
+	// Trap name
+	// zstring
+	// Optional.
+	TNAM *TNAMField
+	// Reference is disabled (always 0). Like UNAM, this will be emitted if the relevant flag is set in the reference's record header. This may only be possible via scripting. Also, even if present in the file, the field appears to be ignored on loading.
+	// uint8
+	// Optional.
+	ZNAM *ZNAMField
+	// Reference position (Rotations are in radians)
+	//   float32 - Position X
+	//   float32 - Position Y
+	//   float32 - Position Z
+	//   float32 - Rotation X
+	//   float32 - Rotation Y
+	//   float32 - Rotation Z
+	// Optional.
+	DATA *DATAFormReferenceField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// formReferenceFields lists the tag that starts each field of FormReference.
+var formReferenceFields = []esm.SubrecordTag{FRMR, NAME, UNAM, XSCL, ANAM, BNAM, CNAM, INDX, XSOL, XCHG, INTV, NAM9, DODT, DNAM, FLTV, KNAM, TNAM, ZNAM, DATAFormReference}
+
+func (r *FormReference) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.FRMR); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.UNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.XSCL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ANAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.BNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.INDX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.XSOL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.XCHG); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.INTV); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.NAM9); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DODT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FLTV); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.KNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.TNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ZNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DATA); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseFormRef parses the FormReference starting at subs[0] and returns it
+// with the number of subrecords it ate.
+func ParseFormRef(subs []*esm.Subrecord, opts ...esm.ParseOption) (*FormReference, int, error) {
+	if subs == nil {
+		return nil, 0, esm.ErrArgumentNil
+	}
+	return parseFormReference(&esm.Record{Tag: CELL, Subrecords: subs}, 0, esm.NewParseOptions(opts...))
+}
+
+// parseFormReference parses the FormReference starting at rec.Subrecords[start].
+// It stops before MVRF, NAM0, or a tag whose field is already set.
+func parseFormReference(rec *esm.Record, start int, o *esm.ParseOptions) (*FormReference, int, error) {
+	r := &FormReference{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		if tag == MVRF || tag == NAM0 {
+			break
+		}
+		field := esm.MatchField(formReferenceFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.FRMR != nil {
+				break fields
+			}
+			r.FRMR, err = esm.ParseField[FRMRField](f, i)
+		case 1:
+			if r.NAME != nil {
+				break fields
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 2:
+			if r.UNAM != nil {
+				break fields
+			}
+			r.UNAM, err = esm.ParseField[UNAMField](f, i)
+		case 3:
+			if r.XSCL != nil {
+				break fields
+			}
+			r.XSCL, err = esm.ParseField[XSCLField](f, i)
+		case 4:
+			if r.ANAM != nil {
+				break fields
+			}
+			r.ANAM, err = esm.ParseField[ANAMField](f, i)
+		case 5:
+			if r.BNAM != nil {
+				break fields
+			}
+			r.BNAM, err = esm.ParseField[BNAMField](f, i)
+		case 6:
+			if r.CNAM != nil {
+				break fields
+			}
+			r.CNAM, err = esm.ParseField[CNAMField](f, i)
+		case 7:
+			if r.INDX != nil {
+				break fields
+			}
+			r.INDX, err = esm.ParseField[INDXField](f, i)
+		case 8:
+			if r.XSOL != nil {
+				break fields
+			}
+			r.XSOL, err = esm.ParseField[XSOLField](f, i)
+		case 9:
+			if r.XCHG != nil {
+				break fields
+			}
+			r.XCHG, err = esm.ParseField[XCHGField](f, i)
+		case 10:
+			if r.INTV != nil {
+				break fields
+			}
+			r.INTV, err = esm.ParseField[INTVField](f, i)
+		case 11:
+			if r.NAM9 != nil {
+				break fields
+			}
+			r.NAM9, err = esm.ParseField[NAM9Field](f, i)
+		case 12:
+			if r.DODT != nil {
+				break fields
+			}
+			r.DODT, err = esm.ParseField[DODTField](f, i)
+		case 13:
+			if r.DNAM != nil {
+				break fields
+			}
+			r.DNAM, err = esm.ParseField[DNAMField](f, i)
+		case 14:
+			if r.FLTV != nil {
+				break fields
+			}
+			r.FLTV, err = esm.ParseField[FLTVField](f, i)
+		case 15:
+			if r.KNAM != nil {
+				break fields
+			}
+			r.KNAM, err = esm.ParseField[KNAMField](f, i)
+		case 16:
+			if r.TNAM != nil {
+				break fields
+			}
+			r.TNAM, err = esm.ParseField[TNAMField](f, i)
+		case 17:
+			if r.ZNAM != nil {
+				break fields
+			}
+			r.ZNAM, err = esm.ParseField[ZNAMField](f, i)
+		case 18:
+			if r.DATA != nil {
+				break fields
+			}
+			r.DATA, err = esm.ParseField[DATAFormReferenceField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.FRMR == nil {
+		if err := f.Missing(FRMR); err != nil {
+			return nil, 0, err
+		}
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
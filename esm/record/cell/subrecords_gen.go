@@ -4,21 +4,31 @@ package cell
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ernmw/omwpacker/esm"
 	"github.com/ernmw/omwpacker/esm/internal/util"
 )
 
-// Name of the cell the reference was moved to (interior cells only) or Faction ID (not light, NPC, or static).
-const CNAM esm.SubrecordTag = "CNAM"
+// Global variable name.
+const BNAM esm.SubrecordTag = "BNAM"
 
-// Name of the cell the reference was moved to (interior cells only) or Faction ID (not light, NPC, or static).
-type CNAMField struct{ Value string }
+// Global variable name.
+type BNAMField struct{ Value string }
 
-func (t *CNAMField) Tag() esm.SubrecordTag { return CNAM }
+func (t *BNAMField) Tag() esm.SubrecordTag { return BNAM }
 
-func (s *CNAMField) Unmarshal(sub *esm.Subrecord) error {
+// Layout implements esm.Structured.
+func (t *BNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *BNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
@@ -29,28 +39,39 @@ func (s *CNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if sub.Data[len(sub.Data)-1] != 0 {
 		return fmt.Errorf("zstring subrecord not null-terminated")
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
 
 	return nil
 }
 
-func (s *CNAMField) Marshal() (*esm.Subrecord, error) {
+func (s *BNAMField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode BNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
 
-// NPC ID, if applicable (NPC-only).
-const ANAM esm.SubrecordTag = "ANAM"
+// Name of the cell the reference was moved to (interior cells only) or Faction ID (not light, NPC, or static).
+const CNAM esm.SubrecordTag = "CNAM"
 
-// NPC ID, if applicable (NPC-only).
-type ANAMField struct{ Value string }
+// Name of the cell the reference was moved to (interior cells only) or Faction ID (not light, NPC, or static).
+type CNAMField struct{ Value string }
 
-func (t *ANAMField) Tag() esm.SubrecordTag { return ANAM }
+func (t *CNAMField) Tag() esm.SubrecordTag { return CNAM }
 
-func (s *ANAMField) Unmarshal(sub *esm.Subrecord) error {
+// Layout implements esm.Structured.
+func (t *CNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
@@ -61,17 +82,21 @@ func (s *ANAMField) Unmarshal(sub *esm.Subrecord) error {
 	if sub.Data[len(sub.Data)-1] != 0 {
 		return fmt.Errorf("zstring subrecord not null-terminated")
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
 
 	return nil
 }
 
-func (s *ANAMField) Marshal() (*esm.Subrecord, error) {
+func (s *CNAMField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
 
 // Coordinates of the cell the reference was moved to (exterior cells only).
@@ -82,10 +107,24 @@ type CNDTField struct{ X, Y int32 }
 
 func (t *CNDTField) Tag() esm.SubrecordTag { return CNDT }
 
+// Size implements esm.Sized.
+func (t *CNDTField) Size() int { return 8 }
+
+// Layout implements esm.Structured.
+func (t *CNDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "X", Type: "i32", Offset: 0, Size: 4},
+		{Name: "Y", Type: "i32", Offset: 4, Size: 4},
+	}
+}
+
 func (s *CNDTField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if len(sub.Data) != 8 {
+		return fmt.Errorf("CNDT must be 8 bytes, got %d", len(sub.Data))
+	}
 	s.X = int32(binary.LittleEndian.Uint32(sub.Data[0:4]))
 	s.Y = int32(binary.LittleEndian.Uint32(sub.Data[4:8]))
 	return nil
@@ -107,61 +146,121 @@ func (s *CNDTField) Marshal() (*esm.Subrecord, error) {
 	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Reference ID for a Form Reference.
-const FRMR esm.SubrecordTag = "FRMR"
+// Cell name for previous DODT, if interior.
+const DNAM esm.SubrecordTag = "DNAM"
 
-// Reference ID for a Form Reference.
-type FRMRField struct{ Value uint32 }
+// Cell name for previous DODT, if interior.
+type DNAMField struct{ Value string }
 
-func (t *FRMRField) Tag() esm.SubrecordTag { return FRMR }
+func (t *DNAMField) Tag() esm.SubrecordTag { return DNAM }
 
-func (s *FRMRField) Unmarshal(sub *esm.Subrecord) error {
+// Layout implements esm.Structured.
+func (t *DNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *DNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
 	return nil
 }
 
-func (s *FRMRField) Marshal() (*esm.Subrecord, error) {
+func (s *DNAMField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	buff := new(bytes.Buffer)
-
-	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
-		return nil, err
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode DNAM: %w", err)
 	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
 
-// Reference ID (always the same as the attached FRMR value).
-const MVRF esm.SubrecordTag = "MVRF"
+// Reference position (Rotations are in radians).
+const DATAFormReference esm.SubrecordTag = "DATA"
 
-// Reference ID (always the same as the attached FRMR value).
-type MVRFField struct{ Value uint32 }
+// Reference position (Rotations are in radians).
+type DATAFormReferenceField struct {
+	PosX float32
+	PosY float32
+	PosZ float32
+	RotX float32
+	RotY float32
+	RotZ float32
+}
 
-func (t *MVRFField) Tag() esm.SubrecordTag { return MVRF }
+func (t *DATAFormReferenceField) Tag() esm.SubrecordTag { return DATAFormReference }
 
-func (s *MVRFField) Unmarshal(sub *esm.Subrecord) error {
+// Size implements esm.Sized.
+func (t *DATAFormReferenceField) Size() int { return 24 }
+
+// Layout implements esm.Structured.
+func (t *DATAFormReferenceField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "PosX", Type: "f32", Offset: 0, Size: 4},
+		{Name: "PosY", Type: "f32", Offset: 4, Size: 4},
+		{Name: "PosZ", Type: "f32", Offset: 8, Size: 4},
+		{Name: "RotX", Type: "f32", Offset: 12, Size: 4},
+		{Name: "RotY", Type: "f32", Offset: 16, Size: 4},
+		{Name: "RotZ", Type: "f32", Offset: 20, Size: 4},
+	}
+}
+
+func (s *DATAFormReferenceField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
+	if len(sub.Data) != 24 {
+		return fmt.Errorf("DATA must be 24 bytes, got %d", len(sub.Data))
+	}
+	s.PosX = util.BytesToFloat32(sub.Data[0:4])
+	s.PosY = util.BytesToFloat32(sub.Data[4:8])
+	s.PosZ = util.BytesToFloat32(sub.Data[8:12])
+	s.RotX = util.BytesToFloat32(sub.Data[12:16])
+	s.RotY = util.BytesToFloat32(sub.Data[16:20])
+	s.RotZ = util.BytesToFloat32(sub.Data[20:24])
 	return nil
 }
 
-func (s *MVRFField) Marshal() (*esm.Subrecord, error) {
+func (s *DATAFormReferenceField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
 	buff := new(bytes.Buffer)
 
-	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+	if err := binary.Write(buff, binary.LittleEndian, s.PosX); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.PosY); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.PosZ); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.RotX); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.RotY); err != nil {
+		return nil, err
+	}
+	if err := binary.Write(buff, binary.LittleEndian, s.RotZ); err != nil {
 		return nil, err
 	}
+
 	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
@@ -180,10 +279,28 @@ type DODTField struct {
 
 func (t *DODTField) Tag() esm.SubrecordTag { return DODT }
 
+// Size implements esm.Sized.
+func (t *DODTField) Size() int { return 24 }
+
+// Layout implements esm.Structured.
+func (t *DODTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "PosX", Type: "f32", Offset: 0, Size: 4},
+		{Name: "PosY", Type: "f32", Offset: 4, Size: 4},
+		{Name: "PosZ", Type: "f32", Offset: 8, Size: 4},
+		{Name: "RotX", Type: "f32", Offset: 12, Size: 4},
+		{Name: "RotY", Type: "f32", Offset: 16, Size: 4},
+		{Name: "RotZ", Type: "f32", Offset: 20, Size: 4},
+	}
+}
+
 func (s *DODTField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if len(sub.Data) != 24 {
+		return fmt.Errorf("DODT must be 24 bytes, got %d", len(sub.Data))
+	}
 	s.PosX = util.BytesToFloat32(sub.Data[0:4])
 	s.PosY = util.BytesToFloat32(sub.Data[4:8])
 	s.PosZ = util.BytesToFloat32(sub.Data[8:12])
@@ -222,33 +339,132 @@ func (s *DODTField) Marshal() (*esm.Subrecord, error) {
 	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Value (uint32).
-const NAM9 esm.SubrecordTag = "NAM9"
+// Cell flags and, for exterior cells, grid position.
+const DATA esm.SubrecordTag = "DATA"
 
-// Value (uint32).
-type NAM9Field struct{ Value uint32 }
+// Cell flags and, for exterior cells, grid position.
+type DATAField struct {
+	Flags CellFlags
+	GridX int32
+	GridY int32
+}
 
-func (t *NAM9Field) Tag() esm.SubrecordTag { return NAM9 }
+func (t *DATAField) Tag() esm.SubrecordTag { return DATA }
 
-func (s *NAM9Field) Unmarshal(sub *esm.Subrecord) error {
+// Size implements esm.Sized.
+func (t *DATAField) Size() int { return 12 }
+
+// Layout implements esm.Structured.
+func (t *DATAField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Flags", Type: "u32", Offset: 0, Size: 4},
+		{Name: "GridX", Type: "i32", Offset: 4, Size: 4},
+		{Name: "GridY", Type: "i32", Offset: 8, Size: 4},
+	}
+}
+
+func (s *DATAField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
+	if len(sub.Data) != 12 {
+		return fmt.Errorf("DATA must be 12 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Flags = CellFlags(binary.LittleEndian.Uint32(d[0:4]))
+	s.GridX = int32(binary.LittleEndian.Uint32(d[4:8]))
+	s.GridY = int32(binary.LittleEndian.Uint32(d[8:12]))
 	return nil
 }
 
-func (s *NAM9Field) Marshal() (*esm.Subrecord, error) {
+func (s *DATAField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
+	d := make([]byte, 12)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Flags))
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.GridX))
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.GridY))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
 
-	buff := new(bytes.Buffer)
+// NPC ID, if applicable (NPC-only).
+const ANAM esm.SubrecordTag = "ANAM"
 
-	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
-		return nil, err
+// NPC ID, if applicable (NPC-only).
+type ANAMField struct{ Value string }
+
+func (t *ANAMField) Tag() esm.SubrecordTag { return ANAM }
+
+// Layout implements esm.Structured.
+func (t *ANAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
 	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
+}
+
+func (s *ANAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ANAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ANAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Water height (interior only).
+const WHGT esm.SubrecordTag = "WHGT"
+
+// Water height (interior only).
+type WHGTField struct{ Value float32 }
+
+func (t *WHGTField) Tag() esm.SubrecordTag { return WHGT }
+
+// Size implements esm.Sized.
+func (t *WHGTField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *WHGTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "f32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *WHGTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("WHGT must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = util.BytesToFloat32(sub.Data[0:4])
+	return nil
+}
+
+func (s *WHGTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
 }
 
 // Map color (exterior and like-exterior only).
@@ -263,10 +479,26 @@ type NAM5Field struct {
 
 func (t *NAM5Field) Tag() esm.SubrecordTag { return NAM5 }
 
+// Size implements esm.Sized.
+func (t *NAM5Field) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *NAM5Field) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "R", Type: "u8", Offset: 0, Size: 1},
+		{Name: "G", Type: "u8", Offset: 1, Size: 1},
+		{Name: "B", Type: "u8", Offset: 2, Size: 1},
+		{Name: "Padding", Type: "x", Offset: 3, Size: 1},
+	}
+}
+
 func (s *NAM5Field) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("NAM5 must be 4 bytes, got %d", len(sub.Data))
+	}
 	s.R = sub.Data[0]
 	s.G = sub.Data[1]
 	s.B = sub.Data[2]
@@ -281,101 +513,222 @@ func (s *NAM5Field) Marshal() (*esm.Subrecord, error) {
 	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.R, s.G, s.B, 0}}, nil
 }
 
-// Remaining usage. uint32 - health remaining (weapons and armor). uint32 - uses remaining (locks, probes, repair items). float32 - time remaining (lights).
-const INTV esm.SubrecordTag = "INTV"
+// Enchantment charge (charged items with non-zero charges), a float32.
+const XCHG esm.SubrecordTag = "XCHG"
 
-// Remaining usage. uint32 - health remaining (weapons and armor). uint32 - uses remaining (locks, probes, repair items). float32 - time remaining (lights).
-type INTVField struct{ Value uint32 }
+// Enchantment charge (charged items with non-zero charges), a float32.
+type XCHGField struct{ Value float32 }
 
-func (t *INTVField) Tag() esm.SubrecordTag { return INTV }
+func (t *XCHGField) Tag() esm.SubrecordTag { return XCHG }
 
-func (s *INTVField) Unmarshal(sub *esm.Subrecord) error {
+// Size implements esm.Sized.
+func (t *XCHGField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *XCHGField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "f32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *XCHGField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("XCHG must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = util.BytesToFloat32(sub.Data[0:4])
 	return nil
 }
 
-func (s *INTVField) Marshal() (*esm.Subrecord, error) {
+func (s *XCHGField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+}
 
-	buff := new(bytes.Buffer)
+// Reference's scale, if applicable and not 1.0.
+const XSCL esm.SubrecordTag = "XSCL"
 
-	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
-		return nil, err
-	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
-}
+// Reference's scale, if applicable and not 1.0.
+type XSCLField struct{ Value float32 }
 
-// Reference position (Rotations are in radians).
-const DATAFormReference esm.SubrecordTag = "DATA"
+func (t *XSCLField) Tag() esm.SubrecordTag { return XSCL }
 
-// Reference position (Rotations are in radians).
-type DATAFormReferenceField struct {
-	PosX float32
-	PosY float32
-	PosZ float32
-	RotX float32
-	RotY float32
-	RotZ float32
+// Size implements esm.Sized.
+func (t *XSCLField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *XSCLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "f32", Offset: 0, Size: 4},
+	}
 }
 
-func (t *DATAFormReferenceField) Tag() esm.SubrecordTag { return DATAFormReference }
-
-func (s *DATAFormReferenceField) Unmarshal(sub *esm.Subrecord) error {
+func (s *XSCLField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.PosX = util.BytesToFloat32(sub.Data[0:4])
-	s.PosY = util.BytesToFloat32(sub.Data[4:8])
-	s.PosZ = util.BytesToFloat32(sub.Data[8:12])
-	s.RotX = util.BytesToFloat32(sub.Data[12:16])
-	s.RotY = util.BytesToFloat32(sub.Data[16:20])
-	s.RotZ = util.BytesToFloat32(sub.Data[20:24])
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("XSCL must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = util.BytesToFloat32(sub.Data[0:4])
 	return nil
 }
 
-func (s *DATAFormReferenceField) Marshal() (*esm.Subrecord, error) {
+func (s *XSCLField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+}
 
-	buff := new(bytes.Buffer)
+// CellFlags are the flags of a cell's DATA subrecord.
+type CellFlags uint32
+
+const (
+	// Set for interior cells.
+	InteriorFlag CellFlags = 0x01
+	HasWaterFlag CellFlags = 0x02
+	// Illegal to sleep here.
+	NoSleepFlag CellFlags = 0x04
+	// Behave like exterior (Tribunal).
+	QuasiExteriorFlag CellFlags = 0x80
+)
 
-	if err := binary.Write(buff, binary.LittleEndian, s.PosX); err != nil {
-		return nil, err
-	}
-	if err := binary.Write(buff, binary.LittleEndian, s.PosY); err != nil {
-		return nil, err
-	}
-	if err := binary.Write(buff, binary.LittleEndian, s.PosZ); err != nil {
-		return nil, err
+// cellFlagsNames lists the named bits of CellFlags, in order.
+var cellFlagsNames = []struct {
+	flag CellFlags
+	name string
+}{
+	{InteriorFlag, "Interior"},
+	{HasWaterFlag, "HasWater"},
+	{NoSleepFlag, "NoSleep"},
+	{QuasiExteriorFlag, "QuasiExterior"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f CellFlags) Has(flag CellFlags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *CellFlags) Set(flag CellFlags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
 	}
-	if err := binary.Write(buff, binary.LittleEndian, s.RotX); err != nil {
-		return nil, err
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f CellFlags) Names() []string {
+	names := []string{}
+	for _, n := range cellFlagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
 	}
-	if err := binary.Write(buff, binary.LittleEndian, s.RotY); err != nil {
-		return nil, err
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
 	}
-	if err := binary.Write(buff, binary.LittleEndian, s.RotZ); err != nil {
-		return nil, err
+	return names
+}
+
+func (f CellFlags) String() string {
+	if f == 0 {
+		return "0"
 	}
+	return strings.Join(f.Names(), "|")
+}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
+// ParseCellFlags combines bit names, as returned by Names, into a CellFlags.
+// Numbers are accepted too.
+func ParseCellFlags(names []string) (CellFlags, error) {
+	var f CellFlags
+next:
+	for _, name := range names {
+		for _, n := range cellFlagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown CellFlags %q", name)
+		}
+		f |= CellFlags(v)
+	}
+	return f, nil
 }
 
-// Region name (exterior and like-exterior only).
-const RGNN esm.SubrecordTag = "RGNN"
+// MarshalJSON encodes f as a list of names.
+func (f CellFlags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
 
-// Region name (exterior and like-exterior only).
-type RGNNField struct{ Value string }
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *CellFlags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = CellFlags(v)
+		return nil
+	}
+	v, err := ParseCellFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
 
-func (t *RGNNField) Tag() esm.SubrecordTag { return RGNN }
+// MarshalYAML encodes f as a list of names.
+func (f CellFlags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
 
-func (s *RGNNField) Unmarshal(sub *esm.Subrecord) error {
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *CellFlags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = CellFlags(v)
+		return nil
+	}
+	v, err := ParseCellFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// ID of soul in gem (soul gems only).
+const XSOL esm.SubrecordTag = "XSOL"
+
+// ID of soul in gem (soul gems only).
+type XSOLField struct{ Value string }
+
+func (t *XSOLField) Tag() esm.SubrecordTag { return XSOL }
+
+// Layout implements esm.Structured.
+func (t *XSOLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *XSOLField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
@@ -386,36 +739,53 @@ func (s *RGNNField) Unmarshal(sub *esm.Subrecord) error {
 	if sub.Data[len(sub.Data)-1] != 0 {
 		return fmt.Errorf("zstring subrecord not null-terminated")
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
 
 	return nil
 }
 
-func (s *RGNNField) Marshal() (*esm.Subrecord, error) {
+func (s *XSOLField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode XSOL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
 
-// Lock difficulty (uint32).
-const FLTV esm.SubrecordTag = "FLTV"
+// Count of Temporary Children.
+const NAM0 esm.SubrecordTag = "NAM0"
 
-// Lock difficulty (uint32).
-type FLTVField struct{ Value uint32 }
+// Count of Temporary Children.
+type NAM0Field struct{ Value uint32 }
 
-func (t *FLTVField) Tag() esm.SubrecordTag { return FLTV }
+func (t *NAM0Field) Tag() esm.SubrecordTag { return NAM0 }
 
-func (s *FLTVField) Unmarshal(sub *esm.Subrecord) error {
+// Size implements esm.Sized.
+func (t *NAM0Field) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *NAM0Field) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *NAM0Field) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("NAM0 must be 4 bytes, got %d", len(sub.Data))
+	}
 	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
 	return nil
 }
 
-func (s *FLTVField) Marshal() (*esm.Subrecord, error) {
+func (s *NAM0Field) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -428,87 +798,120 @@ func (s *FLTVField) Marshal() (*esm.Subrecord, error) {
 	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Trap name.
-const TNAM esm.SubrecordTag = "TNAM"
+// Reference blocked flag (always 0, present if Blocked is set in the header).
+const UNAM esm.SubrecordTag = "UNAM"
 
-// Trap name.
-type TNAMField struct{ Value string }
+// Reference blocked flag (always 0, present if Blocked is set in the header).
+type UNAMField struct{ Value uint8 }
 
-func (t *TNAMField) Tag() esm.SubrecordTag { return TNAM }
+func (t *UNAMField) Tag() esm.SubrecordTag { return UNAM }
 
-func (s *TNAMField) Unmarshal(sub *esm.Subrecord) error {
-	if s == nil || sub == nil {
-		return esm.ErrArgumentNil
+// Size implements esm.Sized.
+func (t *UNAMField) Size() int { return 1 }
+
+// Layout implements esm.Structured.
+func (t *UNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
 	}
+}
 
-	if len(sub.Data) == 0 {
-		return fmt.Errorf("zstring subrecord has no data")
+func (s *UNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
 	}
-	if sub.Data[len(sub.Data)-1] != 0 {
-		return fmt.Errorf("zstring subrecord not null-terminated")
+	if len(sub.Data) != 1 {
+		return fmt.Errorf("UNAM must be 1 bytes, got %d", len(sub.Data))
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
-
+	s.Value = sub.Data[0]
 	return nil
 }
 
-func (s *TNAMField) Marshal() (*esm.Subrecord, error) {
+func (s *UNAMField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.Value}}, nil
 }
 
-// Key name.
-const KNAM esm.SubrecordTag = "KNAM"
+// Reference is disabled flag (always 0, present if the relevant flag is set in the header).
+const ZNAM esm.SubrecordTag = "ZNAM"
 
-// Key name.
-type KNAMField struct{ Value string }
+// Reference is disabled flag (always 0, present if the relevant flag is set in the header).
+type ZNAMField struct{ Value uint8 }
 
-func (t *KNAMField) Tag() esm.SubrecordTag { return KNAM }
+func (t *ZNAMField) Tag() esm.SubrecordTag { return ZNAM }
 
-func (s *KNAMField) Unmarshal(sub *esm.Subrecord) error {
-	if s == nil || sub == nil {
-		return esm.ErrArgumentNil
+// Size implements esm.Sized.
+func (t *ZNAMField) Size() int { return 1 }
+
+// Layout implements esm.Structured.
+func (t *ZNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
 	}
+}
 
-	if len(sub.Data) == 0 {
-		return fmt.Errorf("zstring subrecord has no data")
+func (s *ZNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
 	}
-	if sub.Data[len(sub.Data)-1] != 0 {
-		return fmt.Errorf("zstring subrecord not null-terminated")
+	if len(sub.Data) != 1 {
+		return fmt.Errorf("ZNAM must be 1 bytes, got %d", len(sub.Data))
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
-
+	s.Value = sub.Data[0]
 	return nil
 }
 
-func (s *KNAMField) Marshal() (*esm.Subrecord, error) {
+func (s *ZNAMField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.Value}}, nil
 }
 
-// Faction rank (uint32).
-const INDX esm.SubrecordTag = "INDX"
+// Reference ID (always the same as the attached FRMR value).
+const MVRF esm.SubrecordTag = "MVRF"
 
-// Faction rank (uint32).
-type INDXField struct{ Value uint32 }
+// Reference ID (always the same as the attached FRMR value).
+type MVRFField struct{ Value uint32 }
 
-func (t *INDXField) Tag() esm.SubrecordTag { return INDX }
+func (t *MVRFField) Tag() esm.SubrecordTag { return MVRF }
 
-func (s *INDXField) Unmarshal(sub *esm.Subrecord) error {
+// Size implements esm.Sized.
+func (t *MVRFField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *MVRFField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *MVRFField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("MVRF must be 4 bytes, got %d", len(sub.Data))
+	}
 	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
 	return nil
 }
 
-func (s *INDXField) Marshal() (*esm.Subrecord, error) {
+func (s *MVRFField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -521,55 +924,78 @@ func (s *INDXField) Marshal() (*esm.Subrecord, error) {
 	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Cell Name. Unlike other NAME fields, this is the localized, human-readable name of the cell, not a language-agnostic ID string. Exterior regions are mostly empty strings; for these, the region name is used in the Construction Set.
-const NAME esm.SubrecordTag = "NAME"
+// Faction rank (uint32).
+const INDX esm.SubrecordTag = "INDX"
 
-// Cell Name. Unlike other NAME fields, this is the localized, human-readable name of the cell, not a language-agnostic ID string. Exterior regions are mostly empty strings; for these, the region name is used in the Construction Set.
-type NAMEField struct{ Value string }
+// Faction rank (uint32).
+type INDXField struct{ Value uint32 }
 
-func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+func (t *INDXField) Tag() esm.SubrecordTag { return INDX }
 
-func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
-	if s == nil || sub == nil {
-		return esm.ErrArgumentNil
+// Size implements esm.Sized.
+func (t *INDXField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *INDXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
 	}
+}
 
-	if len(sub.Data) == 0 {
-		return fmt.Errorf("zstring subrecord has no data")
+func (s *INDXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
 	}
-	if sub.Data[len(sub.Data)-1] != 0 {
-		return fmt.Errorf("zstring subrecord not null-terminated")
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("INDX must be 4 bytes, got %d", len(sub.Data))
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
-
+	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
 	return nil
 }
 
-func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+func (s *INDXField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Count of Temporary Children.
-const NAM0 esm.SubrecordTag = "NAM0"
+// Reference ID for a Form Reference.
+const FRMR esm.SubrecordTag = "FRMR"
 
-// Count of Temporary Children.
-type NAM0Field struct{ Value uint32 }
+// Reference ID for a Form Reference.
+type FRMRField struct{ Value uint32 }
 
-func (t *NAM0Field) Tag() esm.SubrecordTag { return NAM0 }
+func (t *FRMRField) Tag() esm.SubrecordTag { return FRMR }
 
-func (s *NAM0Field) Unmarshal(sub *esm.Subrecord) error {
+// Size implements esm.Sized.
+func (t *FRMRField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *FRMRField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *FRMRField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("FRMR must be 4 bytes, got %d", len(sub.Data))
+	}
 	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
 	return nil
 }
 
-func (s *NAM0Field) Marshal() (*esm.Subrecord, error) {
+func (s *FRMRField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -582,46 +1008,36 @@ func (s *NAM0Field) Marshal() (*esm.Subrecord, error) {
 	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Enchantment charge (charged items with non-zero charges), a float32.
-const XCHG esm.SubrecordTag = "XCHG"
+// Lock difficulty (uint32).
+const FLTV esm.SubrecordTag = "FLTV"
 
-// Enchantment charge (charged items with non-zero charges), a float32.
-type XCHGField struct{ Value float32 }
+// Lock difficulty (uint32).
+type FLTVField struct{ Value uint32 }
 
-func (t *XCHGField) Tag() esm.SubrecordTag { return XCHG }
+func (t *FLTVField) Tag() esm.SubrecordTag { return FLTV }
 
-func (s *XCHGField) Unmarshal(sub *esm.Subrecord) error {
-	if s == nil || sub == nil {
-		return esm.ErrArgumentNil
-	}
-	s.Value = util.BytesToFloat32(sub.Data[0:4])
-	return nil
-}
+// Size implements esm.Sized.
+func (t *FLTVField) Size() int { return 4 }
 
-func (s *XCHGField) Marshal() (*esm.Subrecord, error) {
-	if s == nil {
-		return nil, nil
+// Layout implements esm.Structured.
+func (t *FLTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
 	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
 }
 
-// Reference is disabled flag (always 0, present if the relevant flag is set in the header).
-const ZNAM esm.SubrecordTag = "ZNAM"
-
-// Reference is disabled flag (always 0, present if the relevant flag is set in the header).
-type ZNAMField struct{ Value uint8 }
-
-func (t *ZNAMField) Tag() esm.SubrecordTag { return ZNAM }
-
-func (s *ZNAMField) Unmarshal(sub *esm.Subrecord) error {
+func (s *FLTVField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = sub.Data[0]
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("FLTV must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
 	return nil
 }
 
-func (s *ZNAMField) Marshal() (*esm.Subrecord, error) {
+func (s *FLTVField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -631,49 +1047,81 @@ func (s *ZNAMField) Marshal() (*esm.Subrecord, error) {
 	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
 		return nil, err
 	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.Value}}, nil
+	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Reference's scale, if applicable and not 1.0.
-const XSCL esm.SubrecordTag = "XSCL"
+// Value (uint32).
+const NAM9 esm.SubrecordTag = "NAM9"
 
-// Reference's scale, if applicable and not 1.0.
-type XSCLField struct{ Value float32 }
+// Value (uint32).
+type NAM9Field struct{ Value uint32 }
 
-func (t *XSCLField) Tag() esm.SubrecordTag { return XSCL }
+func (t *NAM9Field) Tag() esm.SubrecordTag { return NAM9 }
 
-func (s *XSCLField) Unmarshal(sub *esm.Subrecord) error {
+// Size implements esm.Sized.
+func (t *NAM9Field) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *NAM9Field) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *NAM9Field) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = util.BytesToFloat32(sub.Data[0:4])
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("NAM9 must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
 	return nil
 }
 
-func (s *XSCLField) Marshal() (*esm.Subrecord, error) {
+func (s *NAM9Field) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Reference blocked flag (always 0, present if Blocked is set in the header).
-const UNAM esm.SubrecordTag = "UNAM"
+// Remaining usage. uint32 - health remaining (weapons and armor). uint32 - uses remaining (locks, probes, repair items). float32 - time remaining (lights).
+const INTV esm.SubrecordTag = "INTV"
 
-// Reference blocked flag (always 0, present if Blocked is set in the header).
-type UNAMField struct{ Value uint8 }
+// Remaining usage. uint32 - health remaining (weapons and armor). uint32 - uses remaining (locks, probes, repair items). float32 - time remaining (lights).
+type INTVField struct{ Value uint32 }
 
-func (t *UNAMField) Tag() esm.SubrecordTag { return UNAM }
+func (t *INTVField) Tag() esm.SubrecordTag { return INTV }
 
-func (s *UNAMField) Unmarshal(sub *esm.Subrecord) error {
+// Size implements esm.Sized.
+func (t *INTVField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *INTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *INTVField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = sub.Data[0]
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("INTV must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
 	return nil
 }
 
-func (s *UNAMField) Marshal() (*esm.Subrecord, error) {
+func (s *INTVField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
@@ -683,41 +1131,68 @@ func (s *UNAMField) Marshal() (*esm.Subrecord, error) {
 	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
 		return nil, err
 	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.Value}}, nil
+	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Water height (interior only).
-const WHGT esm.SubrecordTag = "WHGT"
+// Region name (exterior and like-exterior only).
+const RGNN esm.SubrecordTag = "RGNN"
 
-// Water height (interior only).
-type WHGTField struct{ Value float32 }
+// Region name (exterior and like-exterior only).
+type RGNNField struct{ Value string }
 
-func (t *WHGTField) Tag() esm.SubrecordTag { return WHGT }
+func (t *RGNNField) Tag() esm.SubrecordTag { return RGNN }
 
-func (s *WHGTField) Unmarshal(sub *esm.Subrecord) error {
+// Layout implements esm.Structured.
+func (t *RGNNField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *RGNNField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = util.BytesToFloat32(sub.Data[0:4])
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
 	return nil
 }
 
-func (s *WHGTField) Marshal() (*esm.Subrecord, error) {
+func (s *RGNNField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode RGNN: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
 
-// ID of soul in gem (soul gems only).
-const XSOL esm.SubrecordTag = "XSOL"
+// Trap name.
+const TNAM esm.SubrecordTag = "TNAM"
 
-// ID of soul in gem (soul gems only).
-type XSOLField struct{ Value string }
+// Trap name.
+type TNAMField struct{ Value string }
 
-func (t *XSOLField) Tag() esm.SubrecordTag { return XSOL }
+func (t *TNAMField) Tag() esm.SubrecordTag { return TNAM }
 
-func (s *XSOLField) Unmarshal(sub *esm.Subrecord) error {
+// Layout implements esm.Structured.
+func (t *TNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *TNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
@@ -728,28 +1203,39 @@ func (s *XSOLField) Unmarshal(sub *esm.Subrecord) error {
 	if sub.Data[len(sub.Data)-1] != 0 {
 		return fmt.Errorf("zstring subrecord not null-terminated")
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
 
 	return nil
 }
 
-func (s *XSOLField) Marshal() (*esm.Subrecord, error) {
+func (s *TNAMField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode TNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
 
-// Cell name for previous DODT, if interior.
-const DNAM esm.SubrecordTag = "DNAM"
+// Key name.
+const KNAM esm.SubrecordTag = "KNAM"
 
-// Cell name for previous DODT, if interior.
-type DNAMField struct{ Value string }
+// Key name.
+type KNAMField struct{ Value string }
 
-func (t *DNAMField) Tag() esm.SubrecordTag { return DNAM }
+func (t *KNAMField) Tag() esm.SubrecordTag { return KNAM }
 
-func (s *DNAMField) Unmarshal(sub *esm.Subrecord) error {
+// Layout implements esm.Structured.
+func (t *KNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *KNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
@@ -760,28 +1246,39 @@ func (s *DNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if sub.Data[len(sub.Data)-1] != 0 {
 		return fmt.Errorf("zstring subrecord not null-terminated")
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
 
 	return nil
 }
 
-func (s *DNAMField) Marshal() (*esm.Subrecord, error) {
+func (s *KNAMField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode KNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
 
-// Global variable name.
-const BNAM esm.SubrecordTag = "BNAM"
+// Cell Name. Unlike other NAME fields, this is the localized, human-readable name of the cell, not a language-agnostic ID string. Exterior regions are mostly empty strings; for these, the region name is used in the Construction Set.
+const NAME esm.SubrecordTag = "NAME"
 
-// Global variable name.
-type BNAMField struct{ Value string }
+// Cell Name. Unlike other NAME fields, this is the localized, human-readable name of the cell, not a language-agnostic ID string. Exterior regions are mostly empty strings; for these, the region name is used in the Construction Set.
+type NAMEField struct{ Value string }
 
-func (t *BNAMField) Tag() esm.SubrecordTag { return BNAM }
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
 
-func (s *BNAMField) Unmarshal(sub *esm.Subrecord) error {
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
@@ -792,15 +1289,19 @@ func (s *BNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if sub.Data[len(sub.Data)-1] != 0 {
 		return fmt.Errorf("zstring subrecord not null-terminated")
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
 
 	return nil
 }
 
-func (s *BNAMField) Marshal() (*esm.Subrecord, error) {
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
@@ -0,0 +1,163 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package cell
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("ANAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ANAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("BNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &BNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("CNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("CNDT", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CNDTField{X: int32(r.Uint32()), Y: int32(r.Uint32())})
+		}
+	})
+	t.Run("CellFlags", func(t *testing.T) {
+		for range 32 {
+			want := CellFlags(r.Uint64())
+			got, err := ParseCellFlags(want.Names())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("DATA", func(t *testing.T) {
+		for range 32 {
+			s := &DATAField{}
+			s.Flags = CellFlags(r.Uint64())
+			s.GridX = int32(r.Uint64())
+			s.GridY = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("DATAFormReference", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DATAFormReferenceField{
+				PosX: gentest.Float32(r), PosY: gentest.Float32(r), PosZ: gentest.Float32(r),
+				RotX: gentest.Float32(r), RotY: gentest.Float32(r), RotZ: gentest.Float32(r),
+			})
+		}
+	})
+	t.Run("DNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("DODT", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DODTField{
+				PosX: gentest.Float32(r), PosY: gentest.Float32(r), PosZ: gentest.Float32(r),
+				RotX: gentest.Float32(r), RotY: gentest.Float32(r), RotZ: gentest.Float32(r),
+			})
+		}
+	})
+	t.Run("FLTV", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FLTVField{Value: r.Uint32()})
+		}
+	})
+	t.Run("FRMR", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FRMRField{Value: r.Uint32()})
+		}
+	})
+	t.Run("INDX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &INDXField{Value: r.Uint32()})
+		}
+	})
+	t.Run("INTV", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &INTVField{Value: r.Uint32()})
+		}
+	})
+	t.Run("KNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &KNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("MVRF", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MVRFField{Value: r.Uint32()})
+		}
+	})
+	t.Run("NAM0", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAM0Field{Value: r.Uint32()})
+		}
+	})
+	t.Run("NAM5", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAM5Field{R: uint8(r.Uint32()), G: uint8(r.Uint32()), B: uint8(r.Uint32())})
+		}
+	})
+	t.Run("NAM9", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAM9Field{Value: r.Uint32()})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("RGNN", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &RGNNField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("TNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &TNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("UNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &UNAMField{Value: uint8(r.Uint32())})
+		}
+	})
+	t.Run("WHGT", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &WHGTField{Value: gentest.Float32(r)})
+		}
+	})
+	t.Run("XCHG", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &XCHGField{Value: gentest.Float32(r)})
+		}
+	})
+	t.Run("XSCL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &XSCLField{Value: gentest.Float32(r)})
+		}
+	})
+	t.Run("XSOL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &XSOLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("ZNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ZNAMField{Value: uint8(r.Uint32())})
+		}
+	})
+}
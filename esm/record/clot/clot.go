@@ -0,0 +1,32 @@
+// CLOT records contain clothing.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package clot
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/item"
+)
+
+// CLOT handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/CLOT
+const CLOT esm.RecordTag = "CLOT"
+
+func init() {
+	esm.RegisterRecord(CLOT, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		c, err := ParseClothing(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return c, nil
+	})
+	esm.RegisterSubrecords(CLOT, append([]esm.ParsedSubrecord{
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&CTDTField{},
+		&SCRIField{},
+		&ITEXField{},
+		&ENAMField{},
+	}, item.Subrecords()...)...)
+}
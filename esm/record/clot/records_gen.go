@@ -0,0 +1,157 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package clot
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/item"
+)
+
+// ClothingRecord is a piece of clothing, including rings and amulets.
+type ClothingRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	CTDT *CTDTField
+	SCRI *SCRIField
+	ITEX *ITEXField
+	// Body parts the item covers.
+	Parts []*item.Part
+	ENAM  *ENAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// clothingRecordFields lists the tag that starts each field of ClothingRecord.
+var clothingRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, CTDT, SCRI, ITEX, item.INDX, ENAM}
+
+func (r *ClothingRecord) Tag() esm.RecordTag { return CLOT }
+
+func (r *ClothingRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CTDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ITEX); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Parts {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	if out, err = esm.AppendMarshalled(out, r.ENAM); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseClothing builds a ClothingRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseClothing(rec *esm.Record, opts ...esm.ParseOption) (*ClothingRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != CLOT {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseClothingRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseClothingRecord parses the ClothingRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseClothingRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*ClothingRecord, int, error) {
+	r := &ClothingRecord{
+		Parts: []*item.Part{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(clothingRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.CTDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.CTDT, err = esm.ParseField[CTDTField](f, i)
+		case 4:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 5:
+			if r.ITEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.ITEX, err = esm.ParseField[ITEXField](f, i)
+		case 6:
+			var g *item.Part
+			if g, consumed, err = item.ParsePart(rec, i, o); err == nil {
+				r.Parts = append(r.Parts, g)
+			}
+		case 7:
+			if r.ENAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.ENAM, err = esm.ParseField[ENAMField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
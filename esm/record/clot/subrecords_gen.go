@@ -0,0 +1,412 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package clot
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Enchantment ID.
+const ENAM esm.SubrecordTag = "ENAM"
+
+// Enchantment ID.
+type ENAMField struct{ Value string }
+
+func (t *ENAMField) Tag() esm.SubrecordTag { return ENAM }
+
+// Layout implements esm.Structured.
+func (t *ENAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ENAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ENAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ENAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Clothing data.
+const CTDT esm.SubrecordTag = "CTDT"
+
+// Clothing data.
+type CTDTField struct {
+	Kind          Kind
+	Weight        float32
+	Value         uint16
+	EnchantPoints uint16
+}
+
+func (t *CTDTField) Tag() esm.SubrecordTag { return CTDT }
+
+// Size implements esm.Sized.
+func (t *CTDTField) Size() int { return 12 }
+
+// Layout implements esm.Structured.
+func (t *CTDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Kind", Type: "u32", Offset: 0, Size: 4},
+		{Name: "Weight", Type: "f32", Offset: 4, Size: 4},
+		{Name: "Value", Type: "u16", Offset: 8, Size: 2},
+		{Name: "EnchantPoints", Type: "u16", Offset: 10, Size: 2},
+	}
+}
+
+func (s *CTDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 12 {
+		return fmt.Errorf("CTDT must be 12 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Kind = Kind(binary.LittleEndian.Uint32(d[0:4]))
+	s.Weight = math.Float32frombits(binary.LittleEndian.Uint32(d[4:8]))
+	s.Value = binary.LittleEndian.Uint16(d[8:10])
+	s.EnchantPoints = binary.LittleEndian.Uint16(d[10:12])
+	return nil
+}
+
+func (s *CTDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 12)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Kind))
+	binary.LittleEndian.PutUint32(d[4:8], math.Float32bits(float32(s.Weight)))
+	binary.LittleEndian.PutUint16(d[8:10], uint16(s.Value))
+	binary.LittleEndian.PutUint16(d[10:12], uint16(s.EnchantPoints))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Clothing name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Clothing name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Clothing ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Clothing ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Kind is the kind of clothing, from CTDT.
+type Kind uint32
+
+const (
+	PantsKind      Kind = 0
+	ShoesKind      Kind = 1
+	ShirtKind      Kind = 2
+	BeltKind       Kind = 3
+	RobeKind       Kind = 4
+	RightGloveKind Kind = 5
+	LeftGloveKind  Kind = 6
+	SkirtKind      Kind = 7
+	RingKind       Kind = 8
+	AmuletKind     Kind = 9
+)
+
+func (e Kind) String() string {
+	switch e {
+	case PantsKind:
+		return "Pants"
+	case ShoesKind:
+		return "Shoes"
+	case ShirtKind:
+		return "Shirt"
+	case BeltKind:
+		return "Belt"
+	case RobeKind:
+		return "Robe"
+	case RightGloveKind:
+		return "RightGlove"
+	case LeftGloveKind:
+		return "LeftGlove"
+	case SkirtKind:
+		return "Skirt"
+	case RingKind:
+		return "Ring"
+	case AmuletKind:
+		return "Amulet"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Pants":
+		*e = PantsKind
+		return nil
+	case "Shoes":
+		*e = ShoesKind
+		return nil
+	case "Shirt":
+		*e = ShirtKind
+		return nil
+	case "Belt":
+		*e = BeltKind
+		return nil
+	case "Robe":
+		*e = RobeKind
+		return nil
+	case "RightGlove":
+		*e = RightGloveKind
+		return nil
+	case "LeftGlove":
+		*e = LeftGloveKind
+		return nil
+	case "Skirt":
+		*e = SkirtKind
+		return nil
+	case "Ring":
+		*e = RingKind
+		return nil
+	case "Amulet":
+		*e = AmuletKind
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*4)
+	if err != nil {
+		return fmt.Errorf("unknown Kind %q", text)
+	}
+	*e = Kind(v)
+	return nil
+}
+
+// Inventory icon filename.
+const ITEX esm.SubrecordTag = "ITEX"
+
+// Inventory icon filename.
+type ITEXField struct{ Value string }
+
+func (t *ITEXField) Tag() esm.SubrecordTag { return ITEX }
+
+// Layout implements esm.Structured.
+func (t *ITEXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ITEXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ITEXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ITEX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
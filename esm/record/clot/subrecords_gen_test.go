@@ -0,0 +1,65 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package clot
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("CTDT", func(t *testing.T) {
+		for range 32 {
+			s := &CTDTField{}
+			s.Kind = Kind(r.Uint64())
+			s.Weight = gentest.Float32(r)
+			s.Value = uint16(r.Uint64())
+			s.EnchantPoints = uint16(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("ENAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ENAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("ITEX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ITEXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Kind", func(t *testing.T) {
+		for range 32 {
+			want := Kind(r.Uint64())
+			var got Kind
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("MODL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MODLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SCRI", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCRIField{Value: gentest.String(r, 64)})
+		}
+	})
+}
@@ -0,0 +1,32 @@
+// CONT records contain containers.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package cont
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/actor"
+)
+
+// CONT handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/CONT
+const CONT esm.RecordTag = "CONT"
+
+func init() {
+	esm.RegisterRecord(CONT, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		c, err := ParseContainer(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return c, nil
+	})
+	esm.RegisterSubrecords(CONT,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&CNDTField{},
+		&FLAGField{},
+		&SCRIField{},
+		&actor.NPCOField{},
+	)
+}
@@ -0,0 +1,146 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package cont
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/actor"
+)
+
+// ContainerRecord is a container, such as a chest or a plant.
+type ContainerRecord struct {
+	NAME      *NAMEField
+	MODL      *MODLField
+	FNAM      *FNAMField
+	CNDT      *CNDTField
+	FLAG      *FLAGField
+	SCRI      *SCRIField
+	Inventory []*actor.NPCOField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// containerRecordFields lists the tag that starts each field of ContainerRecord.
+var containerRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, CNDT, FLAG, SCRI, actor.NPCO}
+
+func (r *ContainerRecord) Tag() esm.RecordTag { return CONT }
+
+func (r *ContainerRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FLAG); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Inventory {
+		if out, err = esm.AppendMarshalled(out, f); err != nil {
+			return nil, err
+		}
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseContainer builds a ContainerRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseContainer(rec *esm.Record, opts ...esm.ParseOption) (*ContainerRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != CONT {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseContainerRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseContainerRecord parses the ContainerRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseContainerRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*ContainerRecord, int, error) {
+	r := &ContainerRecord{
+		Inventory: []*actor.NPCOField{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(containerRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.CNDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.CNDT, err = esm.ParseField[CNDTField](f, i)
+		case 4:
+			if r.FLAG != nil {
+				f.Keep(i)
+				break
+			}
+			r.FLAG, err = esm.ParseField[FLAGField](f, i)
+		case 5:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 6:
+			var v *actor.NPCOField
+			if v, err = esm.ParseField[actor.NPCOField](f, i); v != nil {
+				r.Inventory = append(r.Inventory, v)
+			}
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,386 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package cont
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Flags holds the bits of a FLAG subrecord.
+type Flags uint32
+
+const (
+	// Contents are harvested, not taken.
+	OrganicFlag  Flags = 0x01
+	RespawnsFlag Flags = 0x02
+	// Set on every container.
+	DefaultFlag Flags = 0x08
+)
+
+// flagsNames lists the named bits of Flags, in order.
+var flagsNames = []struct {
+	flag Flags
+	name string
+}{
+	{OrganicFlag, "Organic"},
+	{RespawnsFlag, "Respawns"},
+	{DefaultFlag, "Default"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f Flags) Has(flag Flags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *Flags) Set(flag Flags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f Flags) Names() []string {
+	names := []string{}
+	for _, n := range flagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseFlags combines bit names, as returned by Names, into a Flags.
+// Numbers are accepted too.
+func ParseFlags(names []string) (Flags, error) {
+	var f Flags
+next:
+	for _, name := range names {
+		for _, n := range flagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown Flags %q", name)
+		}
+		f |= Flags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f Flags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *Flags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f Flags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *Flags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// Container flags.
+const FLAG esm.SubrecordTag = "FLAG"
+
+// Container flags.
+type FLAGField struct{ Value Flags }
+
+func (t *FLAGField) Tag() esm.SubrecordTag { return FLAG }
+
+// Size implements esm.Sized.
+func (t *FLAGField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *FLAGField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *FLAGField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("FLAG must be 4 bytes, got %d", len(sub.Data))
+	}
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, &s.Value)
+	return err
+}
+
+func (s *FLAGField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := binary.Append(nil, binary.LittleEndian, s.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
+
+// Capacity, in weight units.
+const CNDT esm.SubrecordTag = "CNDT"
+
+// Capacity, in weight units.
+type CNDTField struct{ Value float32 }
+
+func (t *CNDTField) Tag() esm.SubrecordTag { return CNDT }
+
+// Size implements esm.Sized.
+func (t *CNDTField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *CNDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "f32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *CNDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("CNDT must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = util.BytesToFloat32(sub.Data[0:4])
+	return nil
+}
+
+func (s *CNDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Container name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Container name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Container ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Container ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,33 @@
+// CREA records contain creatures.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package crea
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/actor"
+)
+
+// CREA handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/CREA
+const CREA esm.RecordTag = "CREA"
+
+func init() {
+	esm.RegisterRecord(CREA, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		c, err := ParseCreature(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return c, nil
+	})
+	esm.RegisterSubrecords(CREA, append([]esm.ParsedSubrecord{
+		&NAMEField{},
+		&MODLField{},
+		&CNAMField{},
+		&FNAMField{},
+		&SCRIField{},
+		&NPDTField{},
+		&FLAGField{},
+		&XSCLField{},
+	}, actor.Subrecords()...)...)
+}
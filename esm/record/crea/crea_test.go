@@ -0,0 +1,60 @@
+package crea
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/ernmw/omwpacker/esm/record/actor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNPDT(t *testing.T) {
+	raw := make([]byte, 96)
+	for i := range 24 {
+		binary.LittleEndian.PutUint32(raw[i*4:], uint32(i))
+	}
+	binary.LittleEndian.PutUint32(raw, uint32(DaedraKind))
+	var data NPDTField
+	require.NoError(t, (&esm.Subrecord{Tag: NPDT, Data: raw}).UnmarshalTo(&data))
+	require.Equal(t, DaedraKind, data.Kind)
+	require.Equal(t, int32(10), data.Health)
+	require.Equal(t, int32(13), data.Soul)
+	require.Equal(t, [6]int32{17, 18, 19, 20, 21, 22}, [6]int32{
+		data.Attack1Min, data.Attack1Max,
+		data.Attack2Min, data.Attack2Max,
+		data.Attack3Min, data.Attack3Max,
+	})
+	require.Equal(t, int32(23), data.Gold)
+
+	out, err := json.Marshal(data.Kind)
+	require.NoError(t, err)
+	require.Equal(t, `"Daedra"`, string(out))
+}
+
+func TestParseCreature(t *testing.T) {
+	rec := &esm.Record{Tag: CREA, Subrecords: gentest.Marshal(t,
+		&NAMEField{Value: "mudcrab"},
+		&FLAGField{Value: SwimsFlag | WalksFlag | NoneFlag},
+		&XSCLField{Value: 0.5},
+		&actor.NPCOField{Count: 1, Item: "ingred_crab_meat_01"},
+		&actor.AIDTField{Fight: 90},
+		&actor.AI_WField{Distance: 200},
+	)}
+
+	c, err := ParseCreature(rec)
+	require.NoError(t, err)
+	require.Equal(t, "None|Swims|Walks", c.FLAG.Value.String())
+	require.Equal(t, float32(0.5), c.XSCL.Value)
+	require.Len(t, c.Inventory, 1)
+	require.Equal(t, []actor.AIPackage{&actor.AIWander{AI_W: &actor.AI_WField{Distance: 200}}}, c.AIPackages)
+
+	out, err := c.OrderedRecords()
+	require.NoError(t, err)
+	require.Equal(t, rec.Subrecords, out)
+
+	_, err = ParseCreature(&esm.Record{Tag: CREA, Subrecords: rec.Subrecords[1:]})
+	require.Error(t, err, "NAME is required")
+}
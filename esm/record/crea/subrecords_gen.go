@@ -0,0 +1,608 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package crea
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Creature data.
+const NPDT esm.SubrecordTag = "NPDT"
+
+// Creature data.
+type NPDTField struct {
+	Kind  Kind
+	Level int32
+	// Strength, Intelligence, Willpower, Agility, Speed, Endurance, Personality, Luck.
+	Attributes  [8]int32
+	Health      int32
+	SpellPoints int32
+	Fatigue     int32
+	// Soul value when trapped in a soul gem.
+	Soul       int32
+	Combat     int32
+	Magic      int32
+	Stealth    int32
+	Attack1Min int32
+	Attack1Max int32
+	Attack2Min int32
+	Attack2Max int32
+	Attack3Min int32
+	Attack3Max int32
+	Gold       int32
+}
+
+func (t *NPDTField) Tag() esm.SubrecordTag { return NPDT }
+
+// Size implements esm.Sized.
+func (t *NPDTField) Size() int { return 96 }
+
+// Layout implements esm.Structured.
+func (t *NPDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Kind", Type: "u32", Offset: 0, Size: 4},
+		{Name: "Level", Type: "i32", Offset: 4, Size: 4},
+		{Name: "Attributes", Type: "i32", Offset: 8, Size: 32, Count: 8},
+		{Name: "Health", Type: "i32", Offset: 40, Size: 4},
+		{Name: "SpellPoints", Type: "i32", Offset: 44, Size: 4},
+		{Name: "Fatigue", Type: "i32", Offset: 48, Size: 4},
+		{Name: "Soul", Type: "i32", Offset: 52, Size: 4},
+		{Name: "Combat", Type: "i32", Offset: 56, Size: 4},
+		{Name: "Magic", Type: "i32", Offset: 60, Size: 4},
+		{Name: "Stealth", Type: "i32", Offset: 64, Size: 4},
+		{Name: "Attack1Min", Type: "i32", Offset: 68, Size: 4},
+		{Name: "Attack1Max", Type: "i32", Offset: 72, Size: 4},
+		{Name: "Attack2Min", Type: "i32", Offset: 76, Size: 4},
+		{Name: "Attack2Max", Type: "i32", Offset: 80, Size: 4},
+		{Name: "Attack3Min", Type: "i32", Offset: 84, Size: 4},
+		{Name: "Attack3Max", Type: "i32", Offset: 88, Size: 4},
+		{Name: "Gold", Type: "i32", Offset: 92, Size: 4},
+	}
+}
+
+func (s *NPDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 96 {
+		return fmt.Errorf("NPDT must be 96 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Kind = Kind(binary.LittleEndian.Uint32(d[0:4]))
+	s.Level = int32(binary.LittleEndian.Uint32(d[4:8]))
+	for i := range s.Attributes {
+		s.Attributes[i] = int32(binary.LittleEndian.Uint32(d[8+i*4 : 8+(i+1)*4]))
+	}
+	s.Health = int32(binary.LittleEndian.Uint32(d[40:44]))
+	s.SpellPoints = int32(binary.LittleEndian.Uint32(d[44:48]))
+	s.Fatigue = int32(binary.LittleEndian.Uint32(d[48:52]))
+	s.Soul = int32(binary.LittleEndian.Uint32(d[52:56]))
+	s.Combat = int32(binary.LittleEndian.Uint32(d[56:60]))
+	s.Magic = int32(binary.LittleEndian.Uint32(d[60:64]))
+	s.Stealth = int32(binary.LittleEndian.Uint32(d[64:68]))
+	s.Attack1Min = int32(binary.LittleEndian.Uint32(d[68:72]))
+	s.Attack1Max = int32(binary.LittleEndian.Uint32(d[72:76]))
+	s.Attack2Min = int32(binary.LittleEndian.Uint32(d[76:80]))
+	s.Attack2Max = int32(binary.LittleEndian.Uint32(d[80:84]))
+	s.Attack3Min = int32(binary.LittleEndian.Uint32(d[84:88]))
+	s.Attack3Max = int32(binary.LittleEndian.Uint32(d[88:92]))
+	s.Gold = int32(binary.LittleEndian.Uint32(d[92:96]))
+	return nil
+}
+
+func (s *NPDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 96)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Kind))
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.Level))
+	for i := range s.Attributes {
+		binary.LittleEndian.PutUint32(d[8+i*4:8+(i+1)*4], uint32(s.Attributes[i]))
+	}
+	binary.LittleEndian.PutUint32(d[40:44], uint32(s.Health))
+	binary.LittleEndian.PutUint32(d[44:48], uint32(s.SpellPoints))
+	binary.LittleEndian.PutUint32(d[48:52], uint32(s.Fatigue))
+	binary.LittleEndian.PutUint32(d[52:56], uint32(s.Soul))
+	binary.LittleEndian.PutUint32(d[56:60], uint32(s.Combat))
+	binary.LittleEndian.PutUint32(d[60:64], uint32(s.Magic))
+	binary.LittleEndian.PutUint32(d[64:68], uint32(s.Stealth))
+	binary.LittleEndian.PutUint32(d[68:72], uint32(s.Attack1Min))
+	binary.LittleEndian.PutUint32(d[72:76], uint32(s.Attack1Max))
+	binary.LittleEndian.PutUint32(d[76:80], uint32(s.Attack2Min))
+	binary.LittleEndian.PutUint32(d[80:84], uint32(s.Attack2Max))
+	binary.LittleEndian.PutUint32(d[84:88], uint32(s.Attack3Min))
+	binary.LittleEndian.PutUint32(d[88:92], uint32(s.Attack3Max))
+	binary.LittleEndian.PutUint32(d[92:96], uint32(s.Gold))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Creature name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Creature name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// ID of the creature whose sounds this one uses.
+const CNAM esm.SubrecordTag = "CNAM"
+
+// ID of the creature whose sounds this one uses.
+type CNAMField struct{ Value string }
+
+func (t *CNAMField) Tag() esm.SubrecordTag { return CNAM }
+
+// Layout implements esm.Structured.
+func (t *CNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *CNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Creature ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Creature ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Kind is the kind of creature, from NPDT.
+type Kind uint32
+
+const (
+	CreatureKind Kind = 0
+	DaedraKind   Kind = 1
+	UndeadKind   Kind = 2
+	HumanoidKind Kind = 3
+)
+
+func (e Kind) String() string {
+	switch e {
+	case CreatureKind:
+		return "Creature"
+	case DaedraKind:
+		return "Daedra"
+	case UndeadKind:
+		return "Undead"
+	case HumanoidKind:
+		return "Humanoid"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Creature":
+		*e = CreatureKind
+		return nil
+	case "Daedra":
+		*e = DaedraKind
+		return nil
+	case "Undead":
+		*e = UndeadKind
+		return nil
+	case "Humanoid":
+		*e = HumanoidKind
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*4)
+	if err != nil {
+		return fmt.Errorf("unknown Kind %q", text)
+	}
+	*e = Kind(v)
+	return nil
+}
+
+// Flags holds the bits of a FLAG subrecord.
+type Flags uint32
+
+const (
+	BipedFlag           Flags = 0x01
+	RespawnFlag         Flags = 0x02
+	WeaponAndShieldFlag Flags = 0x04
+	// Set on most creatures; the game ignores it.
+	NoneFlag      Flags = 0x08
+	SwimsFlag     Flags = 0x10
+	FliesFlag     Flags = 0x20
+	WalksFlag     Flags = 0x40
+	EssentialFlag Flags = 0x80
+	// Bleeds skeleton blood (white).
+	SkeletonBloodFlag Flags = 0x400
+	// Bleeds metal sparks (gold).
+	MetalBloodFlag Flags = 0x800
+)
+
+// flagsNames lists the named bits of Flags, in order.
+var flagsNames = []struct {
+	flag Flags
+	name string
+}{
+	{BipedFlag, "Biped"},
+	{RespawnFlag, "Respawn"},
+	{WeaponAndShieldFlag, "WeaponAndShield"},
+	{NoneFlag, "None"},
+	{SwimsFlag, "Swims"},
+	{FliesFlag, "Flies"},
+	{WalksFlag, "Walks"},
+	{EssentialFlag, "Essential"},
+	{SkeletonBloodFlag, "SkeletonBlood"},
+	{MetalBloodFlag, "MetalBlood"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f Flags) Has(flag Flags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *Flags) Set(flag Flags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f Flags) Names() []string {
+	names := []string{}
+	for _, n := range flagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseFlags combines bit names, as returned by Names, into a Flags.
+// Numbers are accepted too.
+func ParseFlags(names []string) (Flags, error) {
+	var f Flags
+next:
+	for _, name := range names {
+		for _, n := range flagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown Flags %q", name)
+		}
+		f |= Flags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f Flags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *Flags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f Flags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *Flags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// Creature flags.
+const FLAG esm.SubrecordTag = "FLAG"
+
+// Creature flags.
+type FLAGField struct{ Value Flags }
+
+func (t *FLAGField) Tag() esm.SubrecordTag { return FLAG }
+
+// Size implements esm.Sized.
+func (t *FLAGField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *FLAGField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *FLAGField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("FLAG must be 4 bytes, got %d", len(sub.Data))
+	}
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, &s.Value)
+	return err
+}
+
+func (s *FLAGField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := binary.Append(nil, binary.LittleEndian, s.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
+
+// Scale.
+const XSCL esm.SubrecordTag = "XSCL"
+
+// Scale.
+type XSCLField struct{ Value float32 }
+
+func (t *XSCLField) Tag() esm.SubrecordTag { return XSCL }
+
+// Size implements esm.Sized.
+func (t *XSCLField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *XSCLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "f32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *XSCLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("XSCL must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = util.BytesToFloat32(sub.Data[0:4])
+	return nil
+}
+
+func (s *XSCLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+}
@@ -0,0 +1,85 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package crea
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("CNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Flags", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FLAGField{Value: Flags(r.Uint64())})
+		}
+	})
+	t.Run("Kind", func(t *testing.T) {
+		for range 32 {
+			want := Kind(r.Uint64())
+			var got Kind
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("MODL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MODLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NPDT", func(t *testing.T) {
+		for range 32 {
+			s := &NPDTField{}
+			s.Kind = Kind(r.Uint64())
+			s.Level = int32(r.Uint64())
+			for i := range s.Attributes {
+				s.Attributes[i] = int32(r.Uint64())
+			}
+			s.Health = int32(r.Uint64())
+			s.SpellPoints = int32(r.Uint64())
+			s.Fatigue = int32(r.Uint64())
+			s.Soul = int32(r.Uint64())
+			s.Combat = int32(r.Uint64())
+			s.Magic = int32(r.Uint64())
+			s.Stealth = int32(r.Uint64())
+			s.Attack1Min = int32(r.Uint64())
+			s.Attack1Max = int32(r.Uint64())
+			s.Attack2Min = int32(r.Uint64())
+			s.Attack2Max = int32(r.Uint64())
+			s.Attack3Min = int32(r.Uint64())
+			s.Attack3Max = int32(r.Uint64())
+			s.Gold = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("SCRI", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCRIField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("XSCL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &XSCLField{Value: gentest.Float32(r)})
+		}
+	})
+}
@@ -0,0 +1,59 @@
+// DIAL records contain dialogue topics, and the INFO records following
+// each DIAL contain the topic's responses.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package dialogue
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+const (
+	// DIAL handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/DIAL
+	DIAL = esm.DIAL
+	// INFO handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/INFO
+	INFO = esm.INFO
+)
+
+func init() {
+	esm.RegisterRecord(DIAL, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		d, err := ParseDialogue(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	})
+	esm.RegisterRecord(INFO, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		i, err := ParseInfo(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return i, nil
+	})
+	esm.RegisterSubrecords(DIAL,
+		&NAMEField{},
+		&DATAField{},
+	)
+	esm.RegisterSubrecords(INFO,
+		&INAMField{},
+		&PNAMField{},
+		&NNAMField{},
+		&DATAInfoField{},
+		&ONAMField{},
+		&RNAMField{},
+		&CNAMField{},
+		&FNAMField{},
+		&ANAMField{},
+		&DNAMField{},
+		&SNAMField{},
+		&NAMETextField{},
+		&SCVRField{},
+		&INTVField{},
+		&FLTVField{},
+		&BNAMField{},
+		&QSTNField{},
+		&QSTFField{},
+		&QSTRField{},
+	)
+}
@@ -0,0 +1,58 @@
+package dialogue
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// DATA is the kind of a topic, in DIAL.
+const DATA esm.SubrecordTag = "DATA"
+
+// DATAField holds the kind of a topic. The editor writes it as one byte,
+// but some plugins pad it to four; Padding keeps the extra bytes so the
+// subrecord encodes back to its original size.
+type DATAField struct {
+	Value   Kind
+	Padding []byte
+}
+
+func (s *DATAField) Tag() esm.SubrecordTag { return DATA }
+
+// Layout implements esm.Structured.
+func (s *DATAField) Layout() esm.Layout {
+	layout := esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
+	}
+	if len(s.Padding) > 0 {
+		layout = append(layout, esm.LayoutField{Name: "Padding", Type: "x", Offset: 1, Size: -1})
+	}
+	return layout
+}
+
+func (s *DATAField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 1 && len(sub.Data) != 4 {
+		return fmt.Errorf("DATA must be 1 or 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = Kind(sub.Data[0])
+	s.Padding = nil
+	if len(sub.Data) > 1 {
+		s.Padding = bytes.Clone(sub.Data[1:])
+	}
+	return nil
+}
+
+func (s *DATAField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if len(s.Padding) != 0 && len(s.Padding) != 3 {
+		return nil, fmt.Errorf("DATA padding must be 0 or 3 bytes, got %d", len(s.Padding))
+	}
+	data := append([]byte{byte(s.Value)}, s.Padding...)
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
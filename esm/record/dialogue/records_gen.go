@@ -0,0 +1,410 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package dialogue
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// DialogueRecord is a dialogue topic. Its responses are the INFO records that follow it.
+type DialogueRecord struct {
+	NAME *NAMEField
+	DATA *DATAField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// dialogueRecordFields lists the tag that starts each field of DialogueRecord.
+var dialogueRecordFields = []esm.SubrecordTag{NAME, DATA}
+
+func (r *DialogueRecord) Tag() esm.RecordTag { return DIAL }
+
+func (r *DialogueRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DATA); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseDialogue builds a DialogueRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseDialogue(rec *esm.Record, opts ...esm.ParseOption) (*DialogueRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != DIAL {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseDialogueRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseDialogueRecord parses the DialogueRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseDialogueRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*DialogueRecord, int, error) {
+	r := &DialogueRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(dialogueRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.DATA != nil {
+				f.Keep(i)
+				break
+			}
+			r.DATA, err = esm.ParseField[DATAField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// InfoRecord is a response in a dialogue topic.
+type InfoRecord struct {
+	INAM *INAMField
+	PNAM *PNAMField
+	NNAM *NNAMField
+	DATA *DATAInfoField
+	ONAM *ONAMField
+	RNAM *RNAMField
+	CNAM *CNAMField
+	FNAM *FNAMField
+	ANAM *ANAMField
+	DNAM *DNAMField
+	SNAM *SNAMField
+	NAME *NAMETextField
+	// Conditions on the speaker or the player, up to six.
+	Conditions []*Condition
+	BNAM       *BNAMField
+	QSTN       *QSTNField
+	QSTF       *QSTFField
+	QSTR       *QSTRField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// infoRecordFields lists the tag that starts each field of InfoRecord.
+var infoRecordFields = []esm.SubrecordTag{INAM, PNAM, NNAM, DATAInfo, ONAM, RNAM, CNAM, FNAM, ANAM, DNAM, SNAM, NAMEText, SCVR, BNAM, QSTN, QSTF, QSTR}
+
+func (r *InfoRecord) Tag() esm.RecordTag { return INFO }
+
+func (r *InfoRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.INAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.PNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.NNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DATA); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ONAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.RNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ANAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Conditions {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	if out, err = esm.AppendMarshalled(out, r.BNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.QSTN); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.QSTF); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.QSTR); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseInfo builds a InfoRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseInfo(rec *esm.Record, opts ...esm.ParseOption) (*InfoRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != INFO {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseInfoRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseInfoRecord parses the InfoRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseInfoRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*InfoRecord, int, error) {
+	r := &InfoRecord{
+		Conditions: []*Condition{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(infoRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.INAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.INAM, err = esm.ParseField[INAMField](f, i)
+		case 1:
+			if r.PNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.PNAM, err = esm.ParseField[PNAMField](f, i)
+		case 2:
+			if r.NNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.NNAM, err = esm.ParseField[NNAMField](f, i)
+		case 3:
+			if r.DATA != nil {
+				f.Keep(i)
+				break
+			}
+			r.DATA, err = esm.ParseField[DATAInfoField](f, i)
+		case 4:
+			if r.ONAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.ONAM, err = esm.ParseField[ONAMField](f, i)
+		case 5:
+			if r.RNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.RNAM, err = esm.ParseField[RNAMField](f, i)
+		case 6:
+			if r.CNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.CNAM, err = esm.ParseField[CNAMField](f, i)
+		case 7:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 8:
+			if r.ANAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.ANAM, err = esm.ParseField[ANAMField](f, i)
+		case 9:
+			if r.DNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.DNAM, err = esm.ParseField[DNAMField](f, i)
+		case 10:
+			if r.SNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.SNAM, err = esm.ParseField[SNAMField](f, i)
+		case 11:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMETextField](f, i)
+		case 12:
+			var g *Condition
+			if g, consumed, err = parseCondition(rec, i, o); err == nil {
+				r.Conditions = append(r.Conditions, g)
+			}
+		case 13:
+			if r.BNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.BNAM, err = esm.ParseField[BNAMField](f, i)
+		case 14:
+			if r.QSTN != nil {
+				f.Keep(i)
+				break
+			}
+			r.QSTN, err = esm.ParseField[QSTNField](f, i)
+		case 15:
+			if r.QSTF != nil {
+				f.Keep(i)
+				break
+			}
+			r.QSTF, err = esm.ParseField[QSTFField](f, i)
+		case 16:
+			if r.QSTR != nil {
+				f.Keep(i)
+				break
+			}
+			r.QSTR, err = esm.ParseField[QSTRField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.INAM == nil {
+		if err := f.Missing(INAM); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// Condition is a test a response needs to pass, with the value it compares against.
+type Condition struct {
+	SCVR *SCVRField
+	INTV *INTVField
+	FLTV *FLTVField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// conditionFields lists the tag that starts each field of Condition.
+var conditionFields = []esm.SubrecordTag{SCVR, INTV, FLTV}
+
+func (r *Condition) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.SCVR); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.INTV); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FLTV); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// parseCondition parses the Condition starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+func parseCondition(rec *esm.Record, start int, o *esm.ParseOptions) (*Condition, int, error) {
+	r := &Condition{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(conditionFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.SCVR != nil {
+				break fields
+			}
+			r.SCVR, err = esm.ParseField[SCVRField](f, i)
+		case 1:
+			if r.INTV != nil {
+				break fields
+			}
+			r.INTV, err = esm.ParseField[INTVField](f, i)
+		case 2:
+			if r.FLTV != nil {
+				break fields
+			}
+			r.FLTV, err = esm.ParseField[FLTVField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.SCVR == nil {
+		if err := f.Missing(SCVR); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,122 @@
+package dialogue
+
+import (
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// SCVR is a condition on a response. INTV or FLTV after it holds the value
+// the condition compares against.
+const SCVR esm.SubrecordTag = "SCVR"
+
+// ConditionKind is the kind of a condition, from SCVR.
+type ConditionKind byte
+
+const (
+	FunctionCondition   ConditionKind = '1'
+	GlobalCondition     ConditionKind = '2'
+	LocalCondition      ConditionKind = '3'
+	JournalCondition    ConditionKind = '4'
+	ItemCondition       ConditionKind = '5'
+	DeadCondition       ConditionKind = '6'
+	NotIDCondition      ConditionKind = '7'
+	NotFactionCondition ConditionKind = '8'
+	NotClassCondition   ConditionKind = '9'
+	NotRaceCondition    ConditionKind = 'A'
+	NotCellCondition    ConditionKind = 'B'
+	NotLocalCondition   ConditionKind = 'C'
+)
+
+// Comparison is how a condition compares its variable with its value.
+type Comparison byte
+
+const (
+	Equal          Comparison = '0'
+	NotEqual       Comparison = '1'
+	Greater        Comparison = '2'
+	GreaterOrEqual Comparison = '3'
+	Less           Comparison = '4'
+	LessOrEqual    Comparison = '5'
+)
+
+// String returns the comparison as an operator, such as ">=".
+func (c Comparison) String() string {
+	switch c {
+	case Equal:
+		return "="
+	case NotEqual:
+		return "!="
+	case Greater:
+		return ">"
+	case GreaterOrEqual:
+		return ">="
+	case Less:
+		return "<"
+	case LessOrEqual:
+		return "<="
+	}
+	return fmt.Sprintf("Comparison(%q)", byte(c))
+}
+
+// SCVRField is a condition. Its fields are stored as characters followed
+// by the name of the variable, item or object it tests, without a
+// terminating null.
+type SCVRField struct {
+	// Index is the condition's slot, the character '0' through '5'.
+	Index byte
+	Kind  ConditionKind
+	// Function is the two character code of the function a
+	// FunctionCondition calls, or "X" padding for other kinds.
+	Function   string
+	Comparison Comparison
+	// Name is the variable, journal, item or object the condition tests.
+	Name string
+}
+
+func (s *SCVRField) Tag() esm.SubrecordTag { return SCVR }
+
+// Layout implements esm.Structured.
+func (s *SCVRField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Index", Type: "z", Offset: 0, Size: 1},
+		{Name: "Kind", Type: "z", Offset: 1, Size: 1},
+		{Name: "Function", Type: "z", Offset: 2, Size: 2},
+		{Name: "Comparison", Type: "z", Offset: 4, Size: 1},
+		{Name: "Name", Type: "z", Offset: 5, Size: -1},
+	}
+}
+
+func (s *SCVRField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) < 5 {
+		return fmt.Errorf("SCVR must be at least 5 bytes, got %d", len(sub.Data))
+	}
+	*s = SCVRField{
+		Index:      sub.Data[0],
+		Kind:       ConditionKind(sub.Data[1]),
+		Function:   string(sub.Data[2:4]),
+		Comparison: Comparison(sub.Data[4]),
+		Name:       util.DecodeString(sub.Data[5:]),
+	}
+	return nil
+}
+
+func (s *SCVRField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	if len(s.Function) != 2 {
+		return nil, fmt.Errorf("SCVR function must be 2 characters, got %q", s.Function)
+	}
+	name, err := util.EncodeString(s.Name)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCVR: %w", err)
+	}
+	data := append([]byte{s.Index, byte(s.Kind)}, s.Function...)
+	data = append(data, byte(s.Comparison))
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(data, name...)}, nil
+}
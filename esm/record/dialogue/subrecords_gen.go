@@ -0,0 +1,894 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package dialogue
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Result script, run when the response is given.
+const BNAM esm.SubrecordTag = "BNAM"
+
+// Result script, run when the response is given.
+type BNAMField struct{ Value string }
+
+func (t *BNAMField) Tag() esm.SubrecordTag { return BNAM }
+
+// Layout implements esm.Structured.
+func (t *BNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *BNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	s.Value = util.DecodeString(sub.Data)
+
+	return nil
+}
+
+func (s *BNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode BNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: raw}, nil
+}
+
+// Float value a condition compares against.
+const FLTV esm.SubrecordTag = "FLTV"
+
+// Float value a condition compares against.
+type FLTVField struct{ Value float32 }
+
+func (t *FLTVField) Tag() esm.SubrecordTag { return FLTV }
+
+// Size implements esm.Sized.
+func (t *FLTVField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *FLTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "f32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *FLTVField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("FLTV must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = util.BytesToFloat32(sub.Data[0:4])
+	return nil
+}
+
+func (s *FLTVField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+}
+
+// Response text. Stored as NAME.
+const NAMEText esm.SubrecordTag = "NAME"
+
+// Response text. Stored as NAME.
+type NAMETextField struct{ Value string }
+
+func (t *NAMETextField) Tag() esm.SubrecordTag { return NAMEText }
+
+// Layout implements esm.Structured.
+func (t *NAMETextField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMETextField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	s.Value = util.DecodeString(sub.Data)
+
+	return nil
+}
+
+func (s *NAMETextField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: raw}, nil
+}
+
+// Faction the player must be in.
+const DNAM esm.SubrecordTag = "DNAM"
+
+// Faction the player must be in.
+type DNAMField struct{ Value string }
+
+func (t *DNAMField) Tag() esm.SubrecordTag { return DNAM }
+
+// Layout implements esm.Structured.
+func (t *DNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *DNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *DNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode DNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Topic ID. For greetings and the like it is the name of the dialogue kind's topic, such as "Greeting 0".
+const NAME esm.SubrecordTag = "NAME"
+
+// Topic ID. For greetings and the like it is the name of the dialogue kind's topic, such as "Greeting 0".
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Marks the journal entry that names the quest.
+const QSTN esm.SubrecordTag = "QSTN"
+
+// Marks the journal entry that names the quest.
+type QSTNField struct{ Value uint8 }
+
+func (t *QSTNField) Tag() esm.SubrecordTag { return QSTN }
+
+// Size implements esm.Sized.
+func (t *QSTNField) Size() int { return 1 }
+
+// Layout implements esm.Structured.
+func (t *QSTNField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
+	}
+}
+
+func (s *QSTNField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 1 {
+		return fmt.Errorf("QSTN must be 1 bytes, got %d", len(sub.Data))
+	}
+	s.Value = sub.Data[0]
+	return nil
+}
+
+func (s *QSTNField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.Value}}, nil
+}
+
+// Speaker actor ID.
+const ONAM esm.SubrecordTag = "ONAM"
+
+// Speaker actor ID.
+type ONAMField struct{ Value string }
+
+func (t *ONAMField) Tag() esm.SubrecordTag { return ONAM }
+
+// Layout implements esm.Structured.
+func (t *ONAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ONAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ONAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ONAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// ID of the next response, or empty for the last.
+const NNAM esm.SubrecordTag = "NNAM"
+
+// ID of the next response, or empty for the last.
+type NNAMField struct{ Value string }
+
+func (t *NNAMField) Tag() esm.SubrecordTag { return NNAM }
+
+// Layout implements esm.Structured.
+func (t *NNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// ID of the previous response, or empty for the first.
+const PNAM esm.SubrecordTag = "PNAM"
+
+// ID of the previous response, or empty for the first.
+type PNAMField struct{ Value string }
+
+func (t *PNAMField) Tag() esm.SubrecordTag { return PNAM }
+
+// Layout implements esm.Structured.
+func (t *PNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *PNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *PNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode PNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Response ID, unique within its topic.
+const INAM esm.SubrecordTag = "INAM"
+
+// Response ID, unique within its topic.
+type INAMField struct{ Value string }
+
+func (t *INAMField) Tag() esm.SubrecordTag { return INAM }
+
+// Layout implements esm.Structured.
+func (t *INAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *INAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *INAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode INAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Marks the journal entry that restarts the quest.
+const QSTR esm.SubrecordTag = "QSTR"
+
+// Marks the journal entry that restarts the quest.
+type QSTRField struct{ Value uint8 }
+
+func (t *QSTRField) Tag() esm.SubrecordTag { return QSTR }
+
+// Size implements esm.Sized.
+func (t *QSTRField) Size() int { return 1 }
+
+// Layout implements esm.Structured.
+func (t *QSTRField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
+	}
+}
+
+func (s *QSTRField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 1 {
+		return fmt.Errorf("QSTR must be 1 bytes, got %d", len(sub.Data))
+	}
+	s.Value = sub.Data[0]
+	return nil
+}
+
+func (s *QSTRField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.Value}}, nil
+}
+
+// Sound filename.
+const SNAM esm.SubrecordTag = "SNAM"
+
+// Sound filename.
+type SNAMField struct{ Value string }
+
+func (t *SNAMField) Tag() esm.SubrecordTag { return SNAM }
+
+// Layout implements esm.Structured.
+func (t *SNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Integer value a condition compares against.
+const INTV esm.SubrecordTag = "INTV"
+
+// Integer value a condition compares against.
+type INTVField struct {
+	Value int32
+}
+
+func (t *INTVField) Tag() esm.SubrecordTag { return INTV }
+
+// Size implements esm.Sized.
+func (t *INTVField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *INTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "i32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *INTVField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("INTV must be 4 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Value = int32(binary.LittleEndian.Uint32(d[0:4]))
+	return nil
+}
+
+func (s *INTVField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 4)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Value))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Response data. Stored as DATA.
+const DATAInfo esm.SubrecordTag = "DATA"
+
+// Response data. Stored as DATA.
+type DATAInfoField struct {
+	Kind    Kind
+	Unknown [3]uint8
+	// Minimum disposition, or the quest stage for journal entries.
+	Disposition int32
+	// Minimum rank in the speaker's faction, or -1.
+	Rank int8
+	// -1 for any, 0 for male, 1 for female.
+	Sex int8
+	// Minimum rank of the player in the speaker's faction, or -1.
+	PCRank   int8
+	Unknown2 int8
+}
+
+func (t *DATAInfoField) Tag() esm.SubrecordTag { return DATAInfo }
+
+// Size implements esm.Sized.
+func (t *DATAInfoField) Size() int { return 12 }
+
+// Layout implements esm.Structured.
+func (t *DATAInfoField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Kind", Type: "u8", Offset: 0, Size: 1},
+		{Name: "Unknown", Type: "u8", Offset: 1, Size: 3, Count: 3},
+		{Name: "Disposition", Type: "i32", Offset: 4, Size: 4},
+		{Name: "Rank", Type: "i8", Offset: 8, Size: 1},
+		{Name: "Sex", Type: "i8", Offset: 9, Size: 1},
+		{Name: "PCRank", Type: "i8", Offset: 10, Size: 1},
+		{Name: "Unknown2", Type: "i8", Offset: 11, Size: 1},
+	}
+}
+
+func (s *DATAInfoField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 12 {
+		return fmt.Errorf("DATA must be 12 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Kind = Kind(d[0])
+	for i := range s.Unknown {
+		s.Unknown[i] = d[1+i]
+	}
+	s.Disposition = int32(binary.LittleEndian.Uint32(d[4:8]))
+	s.Rank = int8(d[8])
+	s.Sex = int8(d[9])
+	s.PCRank = int8(d[10])
+	s.Unknown2 = int8(d[11])
+	return nil
+}
+
+func (s *DATAInfoField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 12)
+	d[0] = byte(s.Kind)
+	for i := range s.Unknown {
+		d[1+i] = byte(s.Unknown[i])
+	}
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.Disposition))
+	d[8] = byte(s.Rank)
+	d[9] = byte(s.Sex)
+	d[10] = byte(s.PCRank)
+	d[11] = byte(s.Unknown2)
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Marks the journal entry that finishes the quest.
+const QSTF esm.SubrecordTag = "QSTF"
+
+// Marks the journal entry that finishes the quest.
+type QSTFField struct{ Value uint8 }
+
+func (t *QSTFField) Tag() esm.SubrecordTag { return QSTF }
+
+// Size implements esm.Sized.
+func (t *QSTFField) Size() int { return 1 }
+
+// Layout implements esm.Structured.
+func (t *QSTFField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
+	}
+}
+
+func (s *QSTFField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 1 {
+		return fmt.Errorf("QSTF must be 1 bytes, got %d", len(sub.Data))
+	}
+	s.Value = sub.Data[0]
+	return nil
+}
+
+func (s *QSTFField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.Value}}, nil
+}
+
+// Speaker race ID.
+const RNAM esm.SubrecordTag = "RNAM"
+
+// Speaker race ID.
+type RNAMField struct{ Value string }
+
+func (t *RNAMField) Tag() esm.SubrecordTag { return RNAM }
+
+// Layout implements esm.Structured.
+func (t *RNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *RNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *RNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode RNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Speaker faction ID, or "FFFF" for speakers without a faction.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Speaker faction ID, or "FFFF" for speakers without a faction.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Speaker class ID.
+const CNAM esm.SubrecordTag = "CNAM"
+
+// Speaker class ID.
+type CNAMField struct{ Value string }
+
+func (t *CNAMField) Tag() esm.SubrecordTag { return CNAM }
+
+// Layout implements esm.Structured.
+func (t *CNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *CNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Cell the speaker must be in.
+const ANAM esm.SubrecordTag = "ANAM"
+
+// Cell the speaker must be in.
+type ANAMField struct{ Value string }
+
+func (t *ANAMField) Tag() esm.SubrecordTag { return ANAM }
+
+// Layout implements esm.Structured.
+func (t *ANAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ANAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ANAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ANAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Kind is the kind of a dialogue topic, from DATA.
+type Kind uint8
+
+const (
+	TopicKind      Kind = 0
+	VoiceKind      Kind = 1
+	GreetingKind   Kind = 2
+	PersuasionKind Kind = 3
+	JournalKind    Kind = 4
+)
+
+func (e Kind) String() string {
+	switch e {
+	case TopicKind:
+		return "Topic"
+	case VoiceKind:
+		return "Voice"
+	case GreetingKind:
+		return "Greeting"
+	case PersuasionKind:
+		return "Persuasion"
+	case JournalKind:
+		return "Journal"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Topic":
+		*e = TopicKind
+		return nil
+	case "Voice":
+		*e = VoiceKind
+		return nil
+	case "Greeting":
+		*e = GreetingKind
+		return nil
+	case "Persuasion":
+		*e = PersuasionKind
+		return nil
+	case "Journal":
+		*e = JournalKind
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*1)
+	if err != nil {
+		return fmt.Errorf("unknown Kind %q", text)
+	}
+	*e = Kind(v)
+	return nil
+}
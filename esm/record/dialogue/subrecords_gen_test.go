@@ -0,0 +1,132 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package dialogue
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("ANAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ANAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("BNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &BNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("CNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("DATAInfo", func(t *testing.T) {
+		for range 32 {
+			s := &DATAInfoField{}
+			s.Kind = Kind(r.Uint64())
+			for i := range s.Unknown {
+				s.Unknown[i] = uint8(r.Uint64())
+			}
+			s.Disposition = int32(r.Uint64())
+			s.Rank = int8(r.Uint64())
+			s.Sex = int8(r.Uint64())
+			s.PCRank = int8(r.Uint64())
+			s.Unknown2 = int8(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("DNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("FLTV", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FLTVField{Value: gentest.Float32(r)})
+		}
+	})
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("INAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &INAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("INTV", func(t *testing.T) {
+		for range 32 {
+			s := &INTVField{}
+			s.Value = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("Kind", func(t *testing.T) {
+		for range 32 {
+			want := Kind(r.Uint64())
+			var got Kind
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAMEText", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMETextField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("ONAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ONAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("PNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &PNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("QSTF", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &QSTFField{Value: uint8(r.Uint32())})
+		}
+	})
+	t.Run("QSTN", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &QSTNField{Value: uint8(r.Uint32())})
+		}
+	})
+	t.Run("QSTR", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &QSTRField{Value: uint8(r.Uint32())})
+		}
+	})
+	t.Run("RNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &RNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+}
@@ -0,0 +1,294 @@
+package dialogue
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// Topic is a dialogue topic with its responses in the order the game
+// checks them.
+//
+// PNAM and NNAM link each response to its neighbours. Insert, Move and
+// Delete rewrite them, so a Topic owns its records: load the base and the
+// edited topic separately rather than sharing records between them.
+type Topic struct {
+	Dialogue  *DialogueRecord
+	Responses []*InfoRecord
+}
+
+// Deleted reports whether r deletes the response with its INAM.
+func (r *InfoRecord) Deleted() bool {
+	return esm.Deleted(r.Unknown)
+}
+
+// LoadTopic merges the topic id from plugins, which are in load order, the
+// way the game does. The last DIAL record wins. A response replaces the
+// one with the same INAM, keeping its place unless its PNAM changed. New
+// and moved responses go after their PNAM, first if PNAM is empty, or
+// last if PNAM isn't in the topic. Deleted responses are dropped, and so
+// are responses without INAM, which lenient parsing allows; those are
+// reported as Diagnostics. The links of the result are left as loaded.
+func LoadTopic(plugins []*esm.Plugin, id string, opts ...esm.ParseOption) (*Topic, error) {
+	o := esm.NewParseOptions(opts...)
+	key := esm.FoldID(id)
+	t := &Topic{}
+	for _, p := range plugins {
+		in := false
+		for _, rec := range p.Records {
+			switch rec.Tag {
+			case DIAL:
+				name := &NAMEField{}
+				if err := rec.UnmarshalFirst(name); err != nil {
+					return nil, fmt.Errorf("%s: %w", p.Path, err)
+				}
+				if in = esm.FoldID(name.Value) == key; !in {
+					continue
+				}
+				d, err := ParseDialogue(rec, opts...)
+				if err != nil {
+					return nil, fmt.Errorf("%s: DIAL %q: %w", p.Path, name.Value, err)
+				}
+				t.Dialogue = d
+			case INFO:
+				if !in {
+					continue
+				}
+				r, err := ParseInfo(rec, opts...)
+				if err != nil {
+					return nil, fmt.Errorf("%s: INFO in %q: %w", p.Path, id, err)
+				}
+				if r.INAM == nil {
+					o.Reportf(rec, -1, "response in %q has no INAM; skipped", id)
+					continue
+				}
+				t.merge(r)
+			}
+		}
+	}
+	if t.Dialogue == nil {
+		return nil, fmt.Errorf("topic %q not found", id)
+	}
+	return t, nil
+}
+
+// merge applies a loaded response to t.
+func (t *Topic) merge(r *InfoRecord) {
+	i := t.index(r.INAM.Value)
+	if r.Deleted() {
+		if i >= 0 {
+			t.Responses = slices.Delete(t.Responses, i, i+1)
+		}
+		return
+	}
+	if i >= 0 {
+		if esm.FoldID(t.Responses[i].prev()) == esm.FoldID(r.prev()) {
+			t.Responses[i] = r
+			return
+		}
+		t.Responses = slices.Delete(t.Responses, i, i+1)
+	}
+	at := len(t.Responses)
+	if prev := r.prev(); prev == "" {
+		at = 0
+	} else if j := t.index(prev); j >= 0 {
+		at = j + 1
+	}
+	t.Responses = slices.Insert(t.Responses, at, r)
+}
+
+// prev returns the ID of the response before r, or "" if it is first.
+func (r *InfoRecord) prev() string {
+	if r.PNAM == nil {
+		return ""
+	}
+	return r.PNAM.Value
+}
+
+// id returns the INAM of r, or "" if it has none.
+func (r *InfoRecord) id() string {
+	if r.INAM == nil {
+		return ""
+	}
+	return r.INAM.Value
+}
+
+// next returns the ID of the response after r, or "" if it is last.
+func (r *InfoRecord) next() string {
+	if r.NNAM == nil {
+		return ""
+	}
+	return r.NNAM.Value
+}
+
+// index returns the position of the response id, or -1.
+func (t *Topic) index(id string) int {
+	key := esm.FoldID(id)
+	return slices.IndexFunc(t.Responses, func(r *InfoRecord) bool {
+		return r.INAM != nil && esm.FoldID(r.INAM.Value) == key
+	})
+}
+
+// Response returns the response id, or nil.
+func (t *Topic) Response(id string) *InfoRecord {
+	if i := t.index(id); i >= 0 {
+		return t.Responses[i]
+	}
+	return nil
+}
+
+// Insert adds r after the response after, or first if after is empty, and
+// relinks the topic.
+func (t *Topic) Insert(r *InfoRecord, after string) error {
+	if r == nil || r.INAM == nil {
+		return esm.ErrArgumentNil
+	}
+	if t.index(r.INAM.Value) >= 0 {
+		return fmt.Errorf("response %q already exists", r.INAM.Value)
+	}
+	at, err := t.after(after)
+	if err != nil {
+		return err
+	}
+	t.Responses = slices.Insert(t.Responses, at, r)
+	t.relink()
+	return nil
+}
+
+// Move puts the response id after the response after, or first if after
+// is empty, and relinks the topic.
+func (t *Topic) Move(id, after string) error {
+	i := t.index(id)
+	if i < 0 {
+		return fmt.Errorf("response %q not found", id)
+	}
+	if after != "" && esm.FoldID(after) == esm.FoldID(id) {
+		return fmt.Errorf("can't move response %q after itself", id)
+	}
+	r := t.Responses[i]
+	t.Responses = slices.Delete(t.Responses, i, i+1)
+	at, err := t.after(after)
+	if err != nil {
+		t.Responses = slices.Insert(t.Responses, i, r)
+		return err
+	}
+	t.Responses = slices.Insert(t.Responses, at, r)
+	t.relink()
+	return nil
+}
+
+// Delete removes the response id and relinks the topic.
+func (t *Topic) Delete(id string) error {
+	i := t.index(id)
+	if i < 0 {
+		return fmt.Errorf("response %q not found", id)
+	}
+	t.Responses = slices.Delete(t.Responses, i, i+1)
+	t.relink()
+	return nil
+}
+
+// after returns the position following the response id, or 0 if id is
+// empty.
+func (t *Topic) after(id string) (int, error) {
+	if id == "" {
+		return 0, nil
+	}
+	i := t.index(id)
+	if i < 0 {
+		return 0, fmt.Errorf("response %q not found", id)
+	}
+	return i + 1, nil
+}
+
+// relink points the PNAM and NNAM of every response at its neighbours.
+func (t *Topic) relink() {
+	for i, r := range t.Responses {
+		r.PNAM, r.NNAM = &PNAMField{}, &NNAMField{}
+		if i > 0 {
+			r.PNAM.Value = t.Responses[i-1].id()
+		}
+		if i < len(t.Responses)-1 {
+			r.NNAM.Value = t.Responses[i+1].id()
+		}
+	}
+}
+
+// Delta returns the records a plugin needs to turn base into t: the DIAL
+// record, then every response that is new or differs from base, in topic
+// order, then a deleted INFO for every response of base that t no longer
+// has. It returns nil if t and base are the same. base may be nil for a
+// topic the plugin adds.
+func (t *Topic) Delta(base *Topic) ([]*esm.Record, error) {
+	if t.Dialogue == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	dial, err := esm.Encode(t.Dialogue)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		base = &Topic{}
+	}
+	for _, r := range slices.Concat(base.Responses, t.Responses) {
+		if r.INAM == nil {
+			return nil, fmt.Errorf("response without INAM: %w", esm.ErrArgumentNil)
+		}
+	}
+	baseInfos := map[string]*esm.Record{}
+	for _, r := range base.Responses {
+		rec, err := esm.Encode(r)
+		if err != nil {
+			return nil, err
+		}
+		baseInfos[esm.FoldID(r.INAM.Value)] = rec
+	}
+
+	changed := []*esm.Record{}
+	kept := map[string]bool{}
+	for _, r := range t.Responses {
+		rec, err := esm.Encode(r)
+		if err != nil {
+			return nil, err
+		}
+		key := esm.FoldID(r.INAM.Value)
+		kept[key] = true
+		if old, ok := baseInfos[key]; !ok || !sameSubrecords(old.Subrecords, rec.Subrecords) {
+			changed = append(changed, rec)
+		}
+	}
+	for _, r := range base.Responses {
+		if kept[esm.FoldID(r.INAM.Value)] {
+			continue
+		}
+		rec, err := esm.Encode(&InfoRecord{
+			INAM:    r.INAM,
+			PNAM:    &PNAMField{Value: r.prev()},
+			NNAM:    &NNAMField{Value: r.next()},
+			Unknown: []esm.UnknownSubrecord{{Index: 3, Sub: &esm.Subrecord{Tag: esm.DELE, Data: []byte{0, 0, 0, 0}}}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		changed = append(changed, rec)
+	}
+
+	if len(changed) == 0 && base.Dialogue != nil {
+		old, err := esm.Encode(base.Dialogue)
+		if err != nil {
+			return nil, err
+		}
+		if sameSubrecords(old.Subrecords, dial.Subrecords) {
+			return nil, nil
+		}
+	}
+	return append([]*esm.Record{dial}, changed...), nil
+}
+
+func sameSubrecords(a, b []*esm.Subrecord) bool {
+	return slices.EqualFunc(a, b, func(x, y *esm.Subrecord) bool {
+		return x.Tag == y.Tag && bytes.Equal(x.Data, y.Data)
+	})
+}
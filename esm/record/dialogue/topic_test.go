@@ -0,0 +1,222 @@
+package dialogue
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+func dial(t *testing.T, name string) *esm.Record {
+	rec, err := esm.Encode(&DialogueRecord{NAME: &NAMEField{Value: name}, DATA: &DATAField{Value: TopicKind}})
+	require.NoError(t, err)
+	return rec
+}
+
+// info encodes a response with the given links and text.
+func info(t *testing.T, id, prev, next, text string) *esm.Record {
+	rec, err := esm.Encode(&InfoRecord{
+		INAM: &INAMField{Value: id},
+		PNAM: &PNAMField{Value: prev},
+		NNAM: &NNAMField{Value: next},
+		DATA: &DATAInfoField{Rank: -1, Sex: -1, PCRank: -1},
+		NAME: &NAMETextField{Value: text},
+	})
+	require.NoError(t, err)
+	return rec
+}
+
+func deleted(t *testing.T, id string) *esm.Record {
+	rec := info(t, id, "", "", "")
+	rec.Subrecords = append(rec.Subrecords[:3], &esm.Subrecord{Tag: esm.DELE, Data: []byte{0, 0, 0, 0}})
+	return rec
+}
+
+func order(topic *Topic) []string {
+	ids := []string{}
+	for _, r := range topic.Responses {
+		ids = append(ids, r.INAM.Value)
+	}
+	return ids
+}
+
+// requireLinked checks that PNAM and NNAM of every response point at its
+// neighbours.
+func requireLinked(t *testing.T, topic *Topic) {
+	t.Helper()
+	for i, r := range topic.Responses {
+		prev, next := "", ""
+		if i > 0 {
+			prev = topic.Responses[i-1].INAM.Value
+		}
+		if i < len(topic.Responses)-1 {
+			next = topic.Responses[i+1].INAM.Value
+		}
+		require.Equal(t, prev, r.PNAM.Value, r.INAM.Value)
+		require.Equal(t, next, r.NNAM.Value, r.INAM.Value)
+	}
+}
+
+func basePlugins(t *testing.T) []*esm.Plugin {
+	return []*esm.Plugin{
+		{Path: "Morrowind.esm", Records: []*esm.Record{
+			dial(t, "Background"),
+			info(t, "a", "", "b", "A"),
+			info(t, "b", "a", "c", "B"),
+			info(t, "c", "b", "", "C"),
+			dial(t, "little secret"),
+			info(t, "x", "", "", "X"),
+		}},
+	}
+}
+
+func TestLoadTopic(t *testing.T) {
+	plugins := append(basePlugins(t), &esm.Plugin{Path: "patch.esp", Records: []*esm.Record{
+		dial(t, "little secret"),
+		info(t, "b", "", "", "not background"),
+		dial(t, "BACKGROUND"),
+		info(t, "first", "", "a", "first"),
+		info(t, "B", "a", "c", "B edited"),
+		info(t, "after b", "b", "c", "after B"),
+		info(t, "a", "c", "", "A moved"),
+		info(t, "orphan", "missing", "", "orphan"),
+		deleted(t, "C"),
+	}})
+	topic, err := LoadTopic(plugins, "background")
+	require.NoError(t, err)
+	require.Equal(t, "BACKGROUND", topic.Dialogue.NAME.Value)
+	require.Equal(t, []string{"first", "B", "after b", "a", "orphan"}, order(topic))
+	require.Equal(t, "B edited", topic.Response("b").NAME.Value)
+	require.Equal(t, "A moved", topic.Response("a").NAME.Value)
+	require.Nil(t, topic.Response("c"))
+
+	_, err = LoadTopic(plugins, "missing")
+	require.Error(t, err)
+}
+
+func TestLoadTopicWithoutINAM(t *testing.T) {
+	broken := info(t, "y", "x", "", "Y")
+	broken.Subrecords = broken.Subrecords[1:]
+	plugins := append(basePlugins(t), &esm.Plugin{Path: "broken.esp", Records: []*esm.Record{
+		dial(t, "little secret"),
+		broken,
+	}})
+	_, err := LoadTopic(plugins, "little secret")
+	require.Error(t, err)
+
+	var diagnostics []esm.Diagnostic
+	topic, err := LoadTopic(plugins, "little secret", esm.WithLenient(),
+		esm.WithDiagnostics(func(d esm.Diagnostic) { diagnostics = append(diagnostics, d) }))
+	require.NoError(t, err)
+	require.Equal(t, []string{"x"}, order(topic))
+	// The parser reports the missing INAM, then LoadTopic the skip.
+	require.Len(t, diagnostics, 2)
+	require.Contains(t, diagnostics[1].Message, "skipped")
+
+	_, err = topic.Delta(&Topic{Dialogue: topic.Dialogue, Responses: []*InfoRecord{{}}})
+	require.ErrorIs(t, err, esm.ErrArgumentNil)
+}
+
+func TestTopicEdit(t *testing.T) {
+	topic, err := LoadTopic(basePlugins(t), "Background")
+	require.NoError(t, err)
+
+	require.NoError(t, topic.Insert(&InfoRecord{INAM: &INAMField{Value: "new"}}, "a"))
+	require.Equal(t, []string{"a", "new", "b", "c"}, order(topic))
+	requireLinked(t, topic)
+	require.Error(t, topic.Insert(&InfoRecord{INAM: &INAMField{Value: "B"}}, ""))
+	require.Error(t, topic.Insert(&InfoRecord{INAM: &INAMField{Value: "other"}}, "missing"))
+	require.ErrorIs(t, topic.Insert(&InfoRecord{}, ""), esm.ErrArgumentNil)
+
+	require.NoError(t, topic.Move("c", ""))
+	require.Equal(t, []string{"c", "a", "new", "b"}, order(topic))
+	requireLinked(t, topic)
+	require.NoError(t, topic.Move("c", "b"))
+	require.Equal(t, []string{"a", "new", "b", "c"}, order(topic))
+	requireLinked(t, topic)
+	require.Error(t, topic.Move("c", "c"))
+	require.Error(t, topic.Move("c", "missing"))
+	require.Equal(t, []string{"a", "new", "b", "c"}, order(topic))
+	require.Error(t, topic.Move("missing", ""))
+
+	require.NoError(t, topic.Delete("A"))
+	require.Equal(t, []string{"new", "b", "c"}, order(topic))
+	requireLinked(t, topic)
+	require.Error(t, topic.Delete("a"))
+}
+
+func TestTopicDelta(t *testing.T) {
+	base, err := LoadTopic(basePlugins(t), "Background")
+	require.NoError(t, err)
+	edited, err := LoadTopic(basePlugins(t), "Background")
+	require.NoError(t, err)
+
+	delta, err := edited.Delta(base)
+	require.NoError(t, err)
+	require.Nil(t, delta)
+
+	require.NoError(t, edited.Insert(&InfoRecord{INAM: &INAMField{Value: "new"}, NAME: &NAMETextField{Value: "New"}}, "a"))
+	require.NoError(t, edited.Delete("c"))
+	delta, err = edited.Delta(base)
+	require.NoError(t, err)
+	// a and b are relinked to new; c is deleted.
+	require.Len(t, delta, 5)
+	require.Equal(t, DIAL, delta[0].Tag)
+	ids := []string{}
+	for _, rec := range delta[1:] {
+		r, err := ParseInfo(rec)
+		require.NoError(t, err)
+		ids = append(ids, r.INAM.Value)
+		require.Equal(t, r.INAM.Value == "c", r.Deleted())
+	}
+	require.Equal(t, []string{"a", "new", "b", "c"}, ids)
+
+	// Applying the delta on top of the base gives the edited topic.
+	plugins := append(basePlugins(t), &esm.Plugin{Path: "delta.esp", Records: delta})
+	merged, err := LoadTopic(plugins, "Background")
+	require.NoError(t, err)
+	require.Equal(t, order(edited), order(merged))
+	requireLinked(t, merged)
+
+	added, err := edited.Delta(nil)
+	require.NoError(t, err)
+	require.Len(t, added, 4)
+}
+
+func TestSCVR(t *testing.T) {
+	raw := []byte("04JX2MS_Lookout")
+	var s SCVRField
+	require.NoError(t, (&esm.Subrecord{Tag: SCVR, Data: raw}).UnmarshalTo(&s))
+	require.Equal(t, SCVRField{Index: '0', Kind: JournalCondition, Function: "JX", Comparison: Greater, Name: "MS_Lookout"}, s)
+	require.Equal(t, ">", s.Comparison.String())
+	name, err := s.Layout()[4].Value(raw)
+	require.NoError(t, err)
+	require.Equal(t, "MS_Lookout", name)
+
+	sub, err := s.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, raw, sub.Data)
+
+	require.Error(t, (&esm.Subrecord{Tag: SCVR, Data: raw[:4]}).UnmarshalTo(&s))
+	_, err = (&SCVRField{Function: "X"}).Marshal()
+	require.Error(t, err)
+}
+
+func TestDATA(t *testing.T) {
+	for i, data := range [][]byte{{4}, {4, 0xcd, 0xcd, 0xcd}} {
+		rec := &esm.Record{Tag: DIAL, Subrecords: []*esm.Subrecord{
+			{Tag: NAME, Data: []byte("Ashlands\x00")},
+			{Tag: DATA, Data: data},
+		}}
+		d, err := ParseDialogue(rec)
+		require.NoError(t, err)
+		require.Equal(t, JournalKind, d.DATA.Value)
+		again, err := esm.Encode(d)
+		require.NoError(t, err)
+		require.Equal(t, rec.Subrecords, again.Subrecords)
+		require.Len(t, d.DATA.Layout(), i+1)
+	}
+	require.Error(t, (&DATAField{}).Unmarshal(&esm.Subrecord{Tag: DATA, Data: make([]byte, 2)}))
+	_, err := (&DATAField{Padding: []byte{0}}).Marshal()
+	require.Error(t, err)
+}
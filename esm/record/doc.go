@@ -1,2 +1,41 @@
 // Package record contains parsed record and subrecord structs.
+//
+// Importing this package registers every record package with the esm
+// registry, so esm.Decode and esm.DecodeSubrecord can handle them.
 package record
+
+import (
+	_ "github.com/ernmw/omwpacker/esm/record/acti"
+	_ "github.com/ernmw/omwpacker/esm/record/alch"
+	_ "github.com/ernmw/omwpacker/esm/record/appa"
+	_ "github.com/ernmw/omwpacker/esm/record/armo"
+	_ "github.com/ernmw/omwpacker/esm/record/book"
+	_ "github.com/ernmw/omwpacker/esm/record/cell"
+	_ "github.com/ernmw/omwpacker/esm/record/clot"
+	_ "github.com/ernmw/omwpacker/esm/record/cont"
+	_ "github.com/ernmw/omwpacker/esm/record/crea"
+	_ "github.com/ernmw/omwpacker/esm/record/dialogue"
+	_ "github.com/ernmw/omwpacker/esm/record/door"
+	_ "github.com/ernmw/omwpacker/esm/record/ench"
+	_ "github.com/ernmw/omwpacker/esm/record/glob"
+	_ "github.com/ernmw/omwpacker/esm/record/gmst"
+	_ "github.com/ernmw/omwpacker/esm/record/ingr"
+	_ "github.com/ernmw/omwpacker/esm/record/land"
+	_ "github.com/ernmw/omwpacker/esm/record/levc"
+	_ "github.com/ernmw/omwpacker/esm/record/levi"
+	_ "github.com/ernmw/omwpacker/esm/record/ligh"
+	_ "github.com/ernmw/omwpacker/esm/record/lock"
+	_ "github.com/ernmw/omwpacker/esm/record/ltex"
+	_ "github.com/ernmw/omwpacker/esm/record/lua"
+	_ "github.com/ernmw/omwpacker/esm/record/mgef"
+	_ "github.com/ernmw/omwpacker/esm/record/misc"
+	_ "github.com/ernmw/omwpacker/esm/record/npc"
+	_ "github.com/ernmw/omwpacker/esm/record/pathgrid"
+	_ "github.com/ernmw/omwpacker/esm/record/prob"
+	_ "github.com/ernmw/omwpacker/esm/record/repa"
+	_ "github.com/ernmw/omwpacker/esm/record/script"
+	_ "github.com/ernmw/omwpacker/esm/record/spel"
+	_ "github.com/ernmw/omwpacker/esm/record/stat"
+	_ "github.com/ernmw/omwpacker/esm/record/tes3"
+	_ "github.com/ernmw/omwpacker/esm/record/weap"
+)
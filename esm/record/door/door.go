@@ -0,0 +1,28 @@
+// DOOR records contain doors.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package door
+
+import "github.com/ernmw/omwpacker/esm"
+
+// DOOR handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/DOOR
+const DOOR esm.RecordTag = "DOOR"
+
+func init() {
+	esm.RegisterRecord(DOOR, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		d, err := ParseDoor(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	})
+	esm.RegisterSubrecords(DOOR,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&SCRIField{},
+		&SNAMField{},
+		&ANAMField{},
+	)
+}
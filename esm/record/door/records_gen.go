@@ -0,0 +1,132 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package door
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// DoorRecord is a door.
+type DoorRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	SCRI *SCRIField
+	SNAM *SNAMField
+	ANAM *ANAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// doorRecordFields lists the tag that starts each field of DoorRecord.
+var doorRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, SCRI, SNAM, ANAM}
+
+func (r *DoorRecord) Tag() esm.RecordTag { return DOOR }
+
+func (r *DoorRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ANAM); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseDoor builds a DoorRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseDoor(rec *esm.Record, opts ...esm.ParseOption) (*DoorRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != DOOR {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseDoorRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseDoorRecord parses the DoorRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseDoorRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*DoorRecord, int, error) {
+	r := &DoorRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(doorRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 4:
+			if r.SNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.SNAM, err = esm.ParseField[SNAMField](f, i)
+		case 5:
+			if r.ANAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.ANAM, err = esm.ParseField[ANAMField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
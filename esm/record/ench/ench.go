@@ -0,0 +1,28 @@
+// ENCH records contain enchantments.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package ench
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+)
+
+// ENCH handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/ENCH
+const ENCH esm.RecordTag = "ENCH"
+
+func init() {
+	esm.RegisterRecord(ENCH, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		e, err := ParseEnchantment(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return e, nil
+	})
+	esm.RegisterSubrecords(ENCH,
+		&NAMEField{},
+		&ENDTField{},
+		&magic.ENAMField{},
+	)
+}
@@ -0,0 +1,106 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package ench
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+)
+
+// EnchantmentRecord is an enchantment of an item.
+type EnchantmentRecord struct {
+	NAME    *NAMEField
+	ENDT    *ENDTField
+	Effects []*magic.ENAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// enchantmentRecordFields lists the tag that starts each field of EnchantmentRecord.
+var enchantmentRecordFields = []esm.SubrecordTag{NAME, ENDT, magic.ENAM}
+
+func (r *EnchantmentRecord) Tag() esm.RecordTag { return ENCH }
+
+func (r *EnchantmentRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ENDT); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Effects {
+		if out, err = esm.AppendMarshalled(out, f); err != nil {
+			return nil, err
+		}
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseEnchantment builds a EnchantmentRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseEnchantment(rec *esm.Record, opts ...esm.ParseOption) (*EnchantmentRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != ENCH {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseEnchantmentRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseEnchantmentRecord parses the EnchantmentRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseEnchantmentRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*EnchantmentRecord, int, error) {
+	r := &EnchantmentRecord{
+		Effects: []*magic.ENAMField{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(enchantmentRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.ENDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.ENDT, err = esm.ParseField[ENDTField](f, i)
+		case 2:
+			var v *magic.ENAMField
+			if v, err = esm.ParseField[magic.ENAMField](f, i); v != nil {
+				r.Effects = append(r.Effects, v)
+			}
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,283 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package ench
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Enchantment data.
+const ENDT esm.SubrecordTag = "ENDT"
+
+// Enchantment data.
+type ENDTField struct {
+	Kind Kind
+	// Charge used per cast.
+	Cost   int32
+	Charge int32
+	Flags  Flags
+}
+
+func (t *ENDTField) Tag() esm.SubrecordTag { return ENDT }
+
+// Size implements esm.Sized.
+func (t *ENDTField) Size() int { return 16 }
+
+// Layout implements esm.Structured.
+func (t *ENDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Kind", Type: "u32", Offset: 0, Size: 4},
+		{Name: "Cost", Type: "i32", Offset: 4, Size: 4},
+		{Name: "Charge", Type: "i32", Offset: 8, Size: 4},
+		{Name: "Flags", Type: "u32", Offset: 12, Size: 4},
+	}
+}
+
+func (s *ENDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 16 {
+		return fmt.Errorf("ENDT must be 16 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Kind = Kind(binary.LittleEndian.Uint32(d[0:4]))
+	s.Cost = int32(binary.LittleEndian.Uint32(d[4:8]))
+	s.Charge = int32(binary.LittleEndian.Uint32(d[8:12]))
+	s.Flags = Flags(binary.LittleEndian.Uint32(d[12:16]))
+	return nil
+}
+
+func (s *ENDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 16)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Kind))
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.Cost))
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.Charge))
+	binary.LittleEndian.PutUint32(d[12:16], uint32(s.Flags))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Enchantment ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Enchantment ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Kind is how an enchantment is cast, from ENDT.
+type Kind uint32
+
+const (
+	CastOnceKind        Kind = 0
+	CastWhenStrikesKind Kind = 1
+	CastWhenUsedKind    Kind = 2
+	ConstantEffectKind  Kind = 3
+)
+
+func (e Kind) String() string {
+	switch e {
+	case CastOnceKind:
+		return "CastOnce"
+	case CastWhenStrikesKind:
+		return "CastWhenStrikes"
+	case CastWhenUsedKind:
+		return "CastWhenUsed"
+	case ConstantEffectKind:
+		return "ConstantEffect"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "CastOnce":
+		*e = CastOnceKind
+		return nil
+	case "CastWhenStrikes":
+		*e = CastWhenStrikesKind
+		return nil
+	case "CastWhenUsed":
+		*e = CastWhenUsedKind
+		return nil
+	case "ConstantEffect":
+		*e = ConstantEffectKind
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*4)
+	if err != nil {
+		return fmt.Errorf("unknown Kind %q", text)
+	}
+	*e = Kind(v)
+	return nil
+}
+
+// Flags are enchantment flags, from ENDT.
+type Flags uint32
+
+const (
+	// The game calculates cost and charge from the effects.
+	AutocalcFlag Flags = 0x01
+)
+
+// flagsNames lists the named bits of Flags, in order.
+var flagsNames = []struct {
+	flag Flags
+	name string
+}{
+	{AutocalcFlag, "Autocalc"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f Flags) Has(flag Flags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *Flags) Set(flag Flags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f Flags) Names() []string {
+	names := []string{}
+	for _, n := range flagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseFlags combines bit names, as returned by Names, into a Flags.
+// Numbers are accepted too.
+func ParseFlags(names []string) (Flags, error) {
+	var f Flags
+next:
+	for _, name := range names {
+		for _, n := range flagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown Flags %q", name)
+		}
+		f |= Flags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f Flags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *Flags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f Flags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *Flags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
@@ -0,0 +1,48 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package ench
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("ENDT", func(t *testing.T) {
+		for range 32 {
+			s := &ENDTField{}
+			s.Kind = Kind(r.Uint64())
+			s.Cost = int32(r.Uint64())
+			s.Charge = int32(r.Uint64())
+			s.Flags = Flags(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("Flags", func(t *testing.T) {
+		for range 32 {
+			want := Flags(r.Uint64())
+			got, err := ParseFlags(want.Names())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("Kind", func(t *testing.T) {
+		for range 32 {
+			want := Kind(r.Uint64())
+			var got Kind
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+}
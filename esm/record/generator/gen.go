@@ -4,6 +4,7 @@
 package main
 
 import (
+	"cmp"
 	"embed"
 	_ "embed"
 	"encoding/json"
@@ -13,6 +14,7 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -28,13 +30,66 @@ func fourCC(s string) string {
 	return s + strings.Repeat("_", 4-len(s))
 }
 
-var funcMap = template.FuncMap{"fourCC": fourCC}
+// comment formats s as a doc comment, one "//" line per line of s.
+func comment(indent, s string) string {
+	if s == "" {
+		return ""
+	}
+	var sb strings.Builder
+	for line := range strings.SplitSeq(s, "\n") {
+		sb.WriteString(strings.TrimRight("// "+line, " ") + "\n" + indent)
+	}
+	return sb.String()
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// sizeOf returns the size in bytes of an unsigned integer type.
+func sizeOf(base string) (int, error) {
+	switch base {
+	case "uint8":
+		return 1, nil
+	case "uint16":
+		return 2, nil
+	case "uint32":
+		return 4, nil
+	}
+	return 0, fmt.Errorf("unsupported base type %q", base)
+}
+
+// layoutTypes maps Go types to esm.LayoutField types.
+var layoutTypes = map[string]string{
+	"int8": "i8", "uint8": "u8",
+	"int16": "i16", "uint16": "u16",
+	"int32": "i32", "uint32": "u32",
+	"float32": "f32", "char": "z",
+}
+
+func layoutType(base string) string {
+	return layoutTypes[base]
+}
+
+var funcMap = template.FuncMap{
+	"fourCC":     fourCC,
+	"comment":    comment,
+	"lowerFirst": lowerFirst,
+	"sizeOf":     sizeOf,
+	"layoutType": layoutType,
+}
 
 //go:embed *.template
 var templateFiles embed.FS
 
+// loadTemplates parses every embedded template into one set, so templates
+// can include each other by base name.
 func loadTemplates() (map[string]*template.Template, error) {
 	templates := map[string]*template.Template{}
+	root := template.New("").Funcs(funcMap)
 	dir, err := templateFiles.ReadDir(".")
 	if err != nil {
 		return nil, fmt.Errorf("Read embedded templates dir: %w", err)
@@ -48,7 +103,7 @@ func loadTemplates() (map[string]*template.Template, error) {
 		if err != nil {
 			return nil, fmt.Errorf("Read embedded template %q: %w", f.Name(), err)
 		}
-		templates[baseName] = template.Must(template.New(baseName).Funcs(funcMap).Parse(string(content)))
+		templates[baseName] = template.Must(root.New(baseName).Parse(string(content)))
 	}
 	return templates, nil
 }
@@ -57,6 +112,485 @@ type SubrecordInfo struct {
 	Tag      string
 	Template string
 	Comment  string
+
+	// Type names the Go type generated by the flag and enum templates.
+	Type string
+	// Base is the unsigned integer type underlying Type. Defaults to uint32.
+	Base string
+	// Values lists the named bits of a flag type, or the values of an enum.
+	Values []ValueInfo
+
+	// Size is the total size in bytes of a struct subrecord.
+	Size int
+	// Fields lists the fields of a struct subrecord, in order.
+	Fields []*StructField
+	// HasChar is set if any of Fields is a char field.
+	HasChar bool
+}
+
+// StructField is a field of a packed struct subrecord.
+type StructField struct {
+	Name string
+	// Type is int8, uint8, int16, uint16, int32, uint32, float32 or char,
+	// or a named type with one of those as its Base.
+	Type string
+	Base string
+	// Len is the length of a char field, in bytes. Chars are decoded as
+	// null-padded strings.
+	Len int
+	// Count makes the field a fixed array of Count elements.
+	Count int
+	// Offset, if set, must match where the field falls after the fields
+	// before it.
+	Offset  *int
+	Comment string
+
+	GoType string
+	// Decode and Encode are statements that read the field from d and
+	// write it to d. Random sets it to a random value from r.
+	Decode string
+	Encode string
+	Random string
+	// Layout holds the esm.LayoutField literals describing the field.
+	Layout []string
+}
+
+// primitiveSizes are the sizes of the primitive struct field types.
+var primitiveSizes = map[string]int{
+	"int8": 1, "uint8": 1,
+	"int16": 2, "uint16": 2,
+	"int32": 4, "uint32": 4, "float32": 4,
+}
+
+// resolve checks the layout of a struct field starting at offset and
+// returns its size.
+func (f *StructField) resolve(tag string, offset int) (int, error) {
+	if f.Offset != nil && *f.Offset != offset {
+		return 0, fmt.Errorf("%s.%s: declared at offset %d but falls at %d", tag, f.Name, *f.Offset, offset)
+	}
+	base := f.Type
+	named := ""
+	if _, ok := primitiveSizes[base]; !ok && base != "char" {
+		named, base = f.Type, f.Base
+		if _, ok := primitiveSizes[base]; !ok {
+			return 0, fmt.Errorf("%s.%s: %q needs a numeric base, got %q", tag, f.Name, f.Type, f.Base)
+		}
+	}
+	size := primitiveSizes[base]
+	elem := f.Type
+	if base == "char" {
+		if f.Len <= 0 {
+			return 0, fmt.Errorf("%s.%s: char needs a length", tag, f.Name)
+		}
+		size, elem = f.Len, "string"
+	}
+	f.GoType = elem
+	if f.Count < 0 {
+		return 0, fmt.Errorf("%s.%s: negative count", tag, f.Name)
+	}
+	if f.Count > 0 {
+		f.GoType = fmt.Sprintf("[%d]%s", f.Count, elem)
+	}
+
+	at := func(i string) (lo, hi string) {
+		if f.Count == 0 {
+			return fmt.Sprint(offset), fmt.Sprint(offset + size)
+		}
+		if size == 1 {
+			return fmt.Sprintf("%d+%s", offset, i), fmt.Sprintf("%d+%s+1", offset, i)
+		}
+		return fmt.Sprintf("%d+%s*%d", offset, i, size), fmt.Sprintf("%d+(%s+1)*%d", offset, i, size)
+	}
+	lo, hi := at("i")
+	value := "s." + f.Name
+	junkName := strconv.Quote(f.Name)
+	if f.Count > 0 {
+		value += "[i]"
+		junkName = fmt.Sprintf("fmt.Sprintf(\"%s[%%d]\", i)", f.Name)
+	}
+	var decode, encode, random string
+	switch base {
+	case "char":
+		random = fmt.Sprintf("gentest.String(r, %d)", f.Len)
+		decode = fmt.Sprintf("util.ReadFixedString(d[%s:%s], &s.Junk, %s)", lo, hi, junkName)
+		encode = fmt.Sprintf("if err := util.PutFixedString(d[%s:%s], %s, s.Junk, %s); err != nil {\nreturn nil, fmt.Errorf(\"%s.%s: %%w\", err)\n}", lo, hi, value, junkName, fourCC(tag), f.Name)
+	case "int8", "uint8":
+		random = "r.Uint64()"
+		decode = fmt.Sprintf("d[%s]", lo)
+		encode = fmt.Sprintf("d[%s] = byte(%s)", lo, value)
+	case "float32":
+		random = "gentest.Float32(r)"
+		decode = fmt.Sprintf("math.Float32frombits(binary.LittleEndian.Uint32(d[%s:%s]))", lo, hi)
+		encode = fmt.Sprintf("binary.LittleEndian.PutUint32(d[%s:%s], math.Float32bits(float32(%s)))", lo, hi, value)
+	default:
+		bits := 8 * size
+		random = "r.Uint64()"
+		decode = fmt.Sprintf("binary.LittleEndian.Uint%d(d[%s:%s])", bits, lo, hi)
+		encode = fmt.Sprintf("binary.LittleEndian.PutUint%d(d[%s:%s], uint%d(%s))", bits, lo, hi, bits, value)
+	}
+	if named != "" {
+		decode = fmt.Sprintf("%s(%s)", named, decode)
+		random = fmt.Sprintf("%s(%s)", named, random)
+	} else if base != "char" && base != "float32" {
+		if strings.HasPrefix(base, "int") {
+			decode = fmt.Sprintf("%s(%s)", base, decode)
+		}
+		random = fmt.Sprintf("%s(%s)", base, random)
+	}
+	if base == "char" && f.Count > 0 {
+		for i := range f.Count {
+			f.Layout = append(f.Layout, fmt.Sprintf("Name: \"%s[%d]\", Type: \"z\", Offset: %d, Size: %d", f.Name, i, offset+i*size, size))
+		}
+	} else {
+		layout := fmt.Sprintf("Name: %q, Type: %q, Offset: %d, Size: %d", f.Name, layoutTypes[base], offset, size*max(f.Count, 1))
+		if f.Count > 0 {
+			layout += fmt.Sprintf(", Count: %d", f.Count)
+		}
+		f.Layout = []string{layout}
+	}
+	f.Decode = value + " = " + decode
+	f.Encode = encode
+	f.Random = value + " = " + random
+	if f.Count > 0 {
+		f.Decode = fmt.Sprintf("for i := range s.%s {\n%s\n}", f.Name, f.Decode)
+		f.Encode = fmt.Sprintf("for i := range s.%s {\n%s\n}", f.Name, f.Encode)
+		f.Random = fmt.Sprintf("for i := range s.%s {\n%s\n}", f.Name, f.Random)
+	}
+	return size * max(f.Count, 1), nil
+}
+
+// ValueInfo is one named bit or enum value.
+type ValueInfo struct {
+	// Name of the Go constant.
+	Name string
+	// Text is the name used by String and by JSON and YAML. Defaults to Name.
+	Text    string
+	Value   uint64
+	Comment string
+}
+
+// resolve fills in defaults.
+func (s *SubrecordInfo) resolve() error {
+	if len(s.Fields) > 0 {
+		offset := 0
+		for _, f := range s.Fields {
+			size, err := f.resolve(s.Tag, offset)
+			if err != nil {
+				return err
+			}
+			s.HasChar = s.HasChar || f.Type == "char"
+			offset += size
+		}
+		if offset != s.Size {
+			return fmt.Errorf("%s: fields take %d bytes, want %d", s.Tag, offset, s.Size)
+		}
+	}
+	if len(s.Values) == 0 {
+		return nil
+	}
+	if s.Type == "" {
+		return fmt.Errorf("%s: values without a type", s.Tag)
+	}
+	if s.Base == "" {
+		s.Base = "uint32"
+	}
+	if _, err := sizeOf(s.Base); err != nil {
+		return fmt.Errorf("%s: %w", s.Type, err)
+	}
+	texts := map[string]bool{}
+	for i := range s.Values {
+		v := &s.Values[i]
+		if v.Text == "" {
+			v.Text = v.Name
+		}
+		if v.Value == 0 && (s.Template == "flags" || s.Template == "flagset") {
+			return fmt.Errorf("%s: flag %s has no bits", s.Type, v.Name)
+		}
+		if texts[v.Text] {
+			return fmt.Errorf("%s: duplicate value %q", s.Type, v.Text)
+		}
+		texts[v.Text] = true
+	}
+	return nil
+}
+
+// recordPackages is the import path of the record packages, which are
+// siblings of each other.
+const recordPackages = "github.com/ernmw/omwpacker/esm/record/"
+
+// RecordsInfo describes records composed of subrecords, and the groups of
+// subrecords they contain.
+type RecordsInfo struct {
+	Records []*RecordInfo
+	Unions  []*UnionInfo
+
+	// Imports lists the record packages whose groups and subrecords are used.
+	Imports []string
+}
+
+// UnionInfo describes an interface implemented by several groups, so a
+// repeated field can hold them interleaved, in their original order.
+type UnionInfo struct {
+	Name    string
+	Comment string
+	// Members names the groups that implement the union.
+	Members []string
+}
+
+// RecordInfo describes a record, or a group of subrecords within one.
+type RecordInfo struct {
+	// Name of the generated struct.
+	Name string
+	// Tag is the Go constant of the record tag. Groups have none.
+	Tag string
+	// Parser names the generated exported parse func.
+	Parser  string
+	Comment string
+	// End lists the Go constants of tags that end a group. A group also
+	// ends at a tag whose field is already set, such as its own start tag.
+	End []string
+	// Closed groups also end at tags they don't know, instead of keeping
+	// them as unknown.
+	Closed bool
+	// Shared groups are used by records of other packages, which refer to
+	// them, and to unions of them, as package.Name.
+	Shared bool
+	Fields []*FieldInfo
+
+	// RecordTag is the tag of the record a group appears in.
+	RecordTag string
+	// ParseFunc is the func that parses the record or group from a given
+	// subrecord on. Shared groups export it.
+	ParseFunc string
+}
+
+// FieldInfo describes a field of a record.
+type FieldInfo struct {
+	Name string
+	// Type is the subrecord type. Defaults to Name + "Field".
+	Type string
+	// Tag is the Go constant of the subrecord tag. Defaults to Name.
+	Tag string
+	// Group names a RecordInfo that this field holds instead of a
+	// single subrecord. It starts with the tag of its first field.
+	Group    string
+	Comment  string
+	Required bool
+	Repeated bool
+	// CountOf names a repeated field. This field is marshalled as that
+	// field's count, or left out if it is empty, without changing the
+	// record. A parsed value that disagrees with the count is written as
+	// read while the repeated field keeps its parsed length.
+	CountOf string
+	// Union names a UnionInfo that this repeated field holds instead of a
+	// single subrecord or group.
+	Union string
+
+	// StartTag is the Go constant of the tag that starts this field.
+	StartTag string
+	// GoType is the type of the struct field.
+	GoType string
+	// Slots lists the alternatives the field starts with: one for plain
+	// fields and groups, one per member for unions.
+	Slots []*SlotInfo
+}
+
+// SlotInfo is an alternative start of a field.
+type SlotInfo struct {
+	Index    int
+	StartTag string
+	// Group is the group the slot parses, if any, and ParseFunc the func
+	// that parses it.
+	Group     string
+	ParseFunc string
+}
+
+// loadRecords reads and resolves the records file at path.
+func loadRecords(path string) (*RecordsInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	records := &RecordsInfo{}
+	if err := json.Unmarshal(data, records); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if err := records.resolve(filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// resolve fills in defaults and derived fields. dir is the directory of
+// the records file; groups named package.Name are loaded from the records
+// file of the sibling directory package.
+func (r *RecordsInfo) resolve(dir string) error {
+	byName := map[string]*RecordInfo{}
+	for _, rec := range r.Records {
+		if rec.Shared && (rec.Tag != "" || rec.Parser != "") {
+			return fmt.Errorf("%s: only groups without a Parser can be shared", rec.Name)
+		}
+		rec.ParseFunc = "parse" + rec.Name
+		if rec.Shared {
+			rec.ParseFunc = "Parse" + rec.Name
+		}
+		byName[rec.Name] = rec
+	}
+	unions := map[string]*UnionInfo{}
+	for _, u := range r.Unions {
+		unions[u.Name] = u
+	}
+	packages := map[string]*RecordsInfo{}
+	// find returns the records that define name, and the prefix that
+	// qualifies their names here.
+	find := func(name string) (*RecordsInfo, string, string, error) {
+		pkg, local, found := strings.Cut(name, ".")
+		if !found {
+			return r, "", name, nil
+		}
+		info, ok := packages[pkg]
+		if !ok {
+			var err error
+			if info, err = loadRecords(filepath.Join(dir, "..", pkg, "records.json")); err != nil {
+				return nil, "", "", err
+			}
+			packages[pkg] = info
+			r.Imports = append(r.Imports, recordPackages+pkg)
+		}
+		return info, pkg + ".", local, nil
+	}
+	group := func(name string) (*RecordInfo, string, error) {
+		info, prefix, local, err := find(name)
+		if err != nil {
+			return nil, "", err
+		}
+		for _, g := range info.Records {
+			if g.Name == local && len(g.Fields) > 0 && (prefix == "" || g.Shared) {
+				return g, prefix, nil
+			}
+		}
+		return nil, "", fmt.Errorf("unknown, empty or unshared group %q", name)
+	}
+	slot := func(index int, name string) (*SlotInfo, error) {
+		g, prefix, err := group(name)
+		if err != nil {
+			return nil, err
+		}
+		return &SlotInfo{
+			Index:     index,
+			StartTag:  prefix + cmp.Or(g.Fields[0].Tag, g.Fields[0].Name),
+			Group:     name,
+			ParseFunc: prefix + g.ParseFunc,
+		}, nil
+	}
+	for _, rec := range r.Records {
+		index := 0
+		for _, f := range rec.Fields {
+			if f.Tag == "" {
+				f.Tag = f.Name
+			}
+			if f.Type == "" {
+				f.Type = f.Name + "Field"
+			}
+			switch {
+			case f.Union != "":
+				info, prefix, local, err := find(f.Union)
+				if err != nil {
+					return fmt.Errorf("%s.%s: %w", rec.Name, f.Name, err)
+				}
+				u := &UnionInfo{}
+				for _, candidate := range info.Unions {
+					if candidate.Name == local {
+						u = candidate
+					}
+				}
+				if len(u.Members) == 0 || !f.Repeated {
+					return fmt.Errorf("%s.%s: unions must be known and repeated", rec.Name, f.Name)
+				}
+				for _, member := range u.Members {
+					s, err := slot(index, prefix+member)
+					if err != nil {
+						return fmt.Errorf("%s.%s: %w", rec.Name, f.Name, err)
+					}
+					f.Slots = append(f.Slots, s)
+					index++
+				}
+				f.StartTag = f.Slots[0].StartTag
+				f.GoType = f.Union
+			case f.Group != "":
+				s, err := slot(index, f.Group)
+				if err != nil {
+					return fmt.Errorf("%s.%s: %w", rec.Name, f.Name, err)
+				}
+				f.Slots = []*SlotInfo{s}
+				index++
+				f.StartTag = s.StartTag
+				f.GoType = "*" + f.Group
+			default:
+				if pkg, _, found := strings.Cut(f.Type, "."); found && !slices.Contains(r.Imports, recordPackages+pkg) {
+					r.Imports = append(r.Imports, recordPackages+pkg)
+				}
+				f.StartTag = f.Tag
+				f.GoType = "*" + f.Type
+				f.Slots = []*SlotInfo{{Index: index, StartTag: f.StartTag}}
+				index++
+			}
+			if f.Repeated {
+				f.GoType = "[]" + f.GoType
+			}
+		}
+	}
+	// Groups report diagnostics against the record they appear in.
+	var assign func(group *RecordInfo, tag string)
+	assign = func(group *RecordInfo, tag string) {
+		if group == nil || group.RecordTag != "" {
+			return
+		}
+		group.RecordTag = tag
+		for _, f := range group.Fields {
+			for _, s := range f.Slots {
+				assign(byName[s.Group], tag)
+			}
+		}
+	}
+	for _, rec := range r.Records {
+		if rec.Tag != "" {
+			rec.RecordTag = rec.Tag
+			for _, f := range rec.Fields {
+				for _, s := range f.Slots {
+					assign(byName[s.Group], rec.Tag)
+				}
+			}
+		}
+	}
+	for _, rec := range r.Records {
+		if rec.RecordTag == "" && !rec.Shared {
+			return fmt.Errorf("group %q isn't used by any record", rec.Name)
+		}
+	}
+	return nil
+}
+
+// imports returns the record packages r uses. It is safe on nil.
+func (r *RecordsInfo) imports() []string {
+	if r == nil {
+		return nil
+	}
+	return r.Imports
+}
+
+// fieldImports returns the record packages whose types struct fields of
+// tuples use.
+func fieldImports(tuples []SubrecordInfo) []string {
+	imports := []string{}
+	for _, tup := range tuples {
+		for _, f := range tup.Fields {
+			if pkg, _, found := strings.Cut(f.Type, "."); found && !slices.Contains(imports, recordPackages+pkg) {
+				imports = append(imports, recordPackages+pkg)
+			}
+		}
+	}
+	return imports
 }
 
 const headerTemplate = `// Code generated by generator/gen.go; DO NOT EDIT.
@@ -65,11 +599,87 @@ package {{.PackageName}}
 import (
     "github.com/ernmw/omwpacker/esm"
     "github.com/ernmw/omwpacker/esm/internal/util"
+{{- range .Imports}}
+    "{{.}}"
+{{- end}}
     "bytes"
 	"encoding/binary"
 )
 `
 
+const testHeaderTemplate = `// Code generated by generator/gen.go; DO NOT EDIT.
+package {{.PackageName}}
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+{{- range .Imports}}
+	"{{.}}"
+{{- end}}
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from {{.Input}}.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+{{- range .Tests}}
+	t.Run("{{.Name}}", func(t *testing.T) {
+		for range 32 {
+			{{.Body}}
+		}
+	})
+{{- end}}
+}
+`
+
+// generatedTest is a subtest of the generated test file.
+type generatedTest struct {
+	Name string
+	Body string
+}
+
+// writeTests writes the round-trip tests of the types generated from
+// tuples to outputPath.
+func writeTests(outputPath, packageName, input string, templates map[string]*template.Template, tuples []SubrecordInfo, packages []string) error {
+	tests := []generatedTest{}
+	for _, tup := range tuples {
+		tmpl := templates[tup.Template].Lookup("test " + tup.Template)
+		if tmpl == nil {
+			continue
+		}
+		var body strings.Builder
+		if err := tmpl.Execute(&body, tup); err != nil {
+			return err
+		}
+		tests = append(tests, generatedTest{Name: cmp.Or(tup.Type, tup.Tag), Body: body.String()})
+	}
+	if len(tests) == 0 {
+		return nil
+	}
+	slices.SortFunc(tests, func(a, b generatedTest) int { return strings.Compare(a.Name, b.Name) })
+
+	var sb strings.Builder
+	err := template.Must(template.New("test").Parse(testHeaderTemplate)).Execute(&sb, map[string]any{
+		"PackageName": packageName,
+		"Input":       input,
+		"Imports":     packages,
+		"Tests":       tests,
+	})
+	if err != nil {
+		return err
+	}
+	src, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		return fmt.Errorf("format tests: %w", err)
+	}
+	if src, err = imports.Process(outputPath, src, nil); err != nil {
+		return fmt.Errorf("process test imports: %w", err)
+	}
+	return os.WriteFile(outputPath, src, 0666)
+}
+
 func main() {
 	if len(os.Args) < 2 {
 		fmt.Fprintf(os.Stderr, "usage: go run %q <input.json>\n", os.Args[0])
@@ -86,11 +696,21 @@ func main() {
 		panic(fmt.Errorf("read %q: %w", inputPath, err))
 	}
 
-	// Parse JSON into tuples
+	// A JSON object describes records; an array describes subrecords.
+	var records *RecordsInfo
 	var tuples []SubrecordInfo
-	if err := json.Unmarshal(data, &tuples); err != nil {
+	if trimmed := strings.TrimSpace(string(data)); strings.HasPrefix(trimmed, "{") {
+		if records, err = loadRecords(inputPath); err != nil {
+			panic(err)
+		}
+	} else if err := json.Unmarshal(data, &tuples); err != nil {
 		panic(fmt.Errorf("parse json: %w", err))
 	}
+	for i := range tuples {
+		if err := tuples[i].resolve(); err != nil {
+			panic(err)
+		}
+	}
 
 	// Determine output path and package name
 	outDir := filepath.Dir(inputPath)
@@ -104,7 +724,7 @@ func main() {
 	headerTmpl := template.Must(template.New("header").Parse(headerTemplate))
 
 	var sb strings.Builder
-	if err := headerTmpl.Execute(&sb, map[string]any{"PackageName": packageName}); err != nil {
+	if err := headerTmpl.Execute(&sb, map[string]any{"PackageName": packageName, "Imports": append(records.imports(), fieldImports(tuples)...)}); err != nil {
 		panic(err)
 	}
 
@@ -117,6 +737,19 @@ func main() {
 		panic(err)
 	}
 
+	if records != nil {
+		for _, u := range records.Unions {
+			if err := templates["union"].Execute(&sb, u); err != nil {
+				panic(err)
+			}
+		}
+		for _, rec := range records.Records {
+			if err := templates["record"].Execute(&sb, rec); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	for _, tup := range tuples {
 		tmpl, ok := templates[tup.Template]
 		if !ok {
@@ -152,4 +785,12 @@ func main() {
 	}
 
 	fmt.Printf("✅ Generated: %s\n", outputPath)
+
+	if records == nil {
+		testPath := filepath.Join(outDir, base+"_gen_test.go")
+		if err := writeTests(testPath, packageName, filepath.Base(inputPath), templates, tuples, fieldImports(tuples)); err != nil {
+			panic(fmt.Errorf("write tests: %w", err))
+		}
+		fmt.Printf("✅ Generated: %s\n", testPath)
+	}
 }
@@ -0,0 +1,53 @@
+// GLOB records contain global script variables.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package glob
+
+import (
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// GLOB handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/GLOB
+const GLOB esm.RecordTag = "GLOB"
+
+func init() {
+	esm.RegisterRecord(GLOB, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		g, err := ParseGlobal(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return g, nil
+	})
+	esm.RegisterSubrecords(GLOB,
+		&NAMEField{},
+		&FNAMField{},
+		&FLTVField{},
+	)
+}
+
+// Value returns the variable's value as its type: an int16 for a short, an
+// int32 for a long or a float32 for a float. A missing FLTV is zero.
+func (r *GlobalRecord) Value() (any, error) {
+	if r.NAME == nil {
+		return nil, fmt.Errorf("NAME: %w", esm.ErrSubrecordNotFound)
+	}
+	if r.FNAM == nil {
+		return nil, fmt.Errorf("global %q has no FNAM", r.NAME.Value)
+	}
+	var v float32
+	if r.FLTV != nil {
+		v = r.FLTV.Value
+	}
+	switch r.FNAM.Value {
+	case ShortKind:
+		return int16(v), nil
+	case LongKind:
+		return int32(v), nil
+	case FloatKind:
+		return v, nil
+	}
+	return nil, fmt.Errorf("global %q has unknown type %v", r.NAME.Value, r.FNAM.Value)
+}
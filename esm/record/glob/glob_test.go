@@ -0,0 +1,35 @@
+package glob
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValue(t *testing.T) {
+	for _, tc := range []struct {
+		kind Kind
+		want any
+	}{
+		{ShortKind, int16(-3)},
+		{LongKind, int32(-3)},
+		{FloatKind, float32(-3.75)},
+	} {
+		g := &GlobalRecord{NAME: &NAMEField{Value: "GameHour"}, FNAM: &FNAMField{Value: tc.kind}, FLTV: &FLTVField{Value: -3.75}}
+		v, err := g.Value()
+		require.NoError(t, err)
+		require.Equal(t, tc.want, v)
+	}
+
+	v, err := (&GlobalRecord{NAME: &NAMEField{Value: "Day"}, FNAM: &FNAMField{Value: LongKind}}).Value()
+	require.NoError(t, err)
+	require.Equal(t, int32(0), v)
+
+	_, err = (&GlobalRecord{NAME: &NAMEField{Value: "Day"}}).Value()
+	require.Error(t, err)
+	_, err = (&GlobalRecord{NAME: &NAMEField{Value: "Day"}, FNAM: &FNAMField{Value: 'x'}}).Value()
+	require.Error(t, err)
+	_, err = (&GlobalRecord{FNAM: &FNAMField{Value: LongKind}}).Value()
+	require.ErrorIs(t, err, esm.ErrSubrecordNotFound)
+}
@@ -0,0 +1,102 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package glob
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// GlobalRecord is a global script variable.
+type GlobalRecord struct {
+	NAME *NAMEField
+	FNAM *FNAMField
+	FLTV *FLTVField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// globalRecordFields lists the tag that starts each field of GlobalRecord.
+var globalRecordFields = []esm.SubrecordTag{NAME, FNAM, FLTV}
+
+func (r *GlobalRecord) Tag() esm.RecordTag { return GLOB }
+
+func (r *GlobalRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FLTV); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseGlobal builds a GlobalRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseGlobal(rec *esm.Record, opts ...esm.ParseOption) (*GlobalRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != GLOB {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseGlobalRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseGlobalRecord parses the GlobalRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseGlobalRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*GlobalRecord, int, error) {
+	r := &GlobalRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(globalRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 2:
+			if r.FLTV != nil {
+				f.Keep(i)
+				break
+			}
+			r.FLTV, err = esm.ParseField[FLTVField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,177 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package glob
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Kind is the value of a FNAM subrecord.
+type Kind uint8
+
+const (
+	ShortKind Kind = 115
+	LongKind  Kind = 108
+	FloatKind Kind = 102
+)
+
+func (e Kind) String() string {
+	switch e {
+	case ShortKind:
+		return "Short"
+	case LongKind:
+		return "Long"
+	case FloatKind:
+		return "Float"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Short":
+		*e = ShortKind
+		return nil
+	case "Long":
+		*e = LongKind
+		return nil
+	case "Float":
+		*e = FloatKind
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*1)
+	if err != nil {
+		return fmt.Errorf("unknown Kind %q", text)
+	}
+	*e = Kind(v)
+	return nil
+}
+
+// Type of the variable.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Type of the variable.
+type FNAMField struct{ Value Kind }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Size implements esm.Sized.
+func (t *FNAMField) Size() int { return 1 }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 1 {
+		return fmt.Errorf("FNAM must be 1 bytes, got %d", len(sub.Data))
+	}
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, &s.Value)
+	return err
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := binary.Append(nil, binary.LittleEndian, s.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
+
+// Value of the variable, stored as a float whatever its type.
+const FLTV esm.SubrecordTag = "FLTV"
+
+// Value of the variable, stored as a float whatever its type.
+type FLTVField struct{ Value float32 }
+
+func (t *FLTVField) Tag() esm.SubrecordTag { return FLTV }
+
+// Size implements esm.Sized.
+func (t *FLTVField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *FLTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "f32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *FLTVField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("FLTV must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = util.BytesToFloat32(sub.Data[0:4])
+	return nil
+}
+
+func (s *FLTVField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+}
+
+// Global variable ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Global variable ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,29 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package glob
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("FLTV", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FLTVField{Value: gentest.Float32(r)})
+		}
+	})
+	t.Run("Kind", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: Kind(r.Uint64())})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package gmst
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// defaultsJSON holds a table of vanilla settings: their values by name,
+// and whether the table lists every vanilla setting. String settings are
+// translated in localized masters, so their values are null. The
+// checked-in table is partial; rebuild the complete one from the master
+// files with
+//
+//	go run ./internal/gendefaults -o defaults.json Morrowind.esm Tribunal.esm Bloodmoon.esm
+//
+//go:embed defaults.json
+var defaultsJSON []byte
+
+// defaultsTable is defaultsJSON decoded, with settings by folded name.
+type defaultsTable struct {
+	complete bool
+	settings map[string]any
+}
+
+var defaults = sync.OnceValue(func() defaultsTable {
+	var raw struct {
+		Complete bool
+		Settings map[string]json.RawMessage
+	}
+	if err := json.Unmarshal(defaultsJSON, &raw); err != nil {
+		panic(fmt.Errorf("defaults.json: %w", err))
+	}
+	table := defaultsTable{complete: raw.Complete, settings: make(map[string]any, len(raw.Settings))}
+	for name, msg := range raw.Settings {
+		v, err := decodeDefault(name, msg)
+		if err != nil {
+			panic(fmt.Errorf("defaults.json: %w", err))
+		}
+		table.settings[esm.FoldID(name)] = v
+	}
+	return table
+})
+
+// decodeDefault decodes msg as the type the setting name gives, or nil if
+// msg is null.
+func decodeDefault(name string, msg json.RawMessage) (any, error) {
+	if name == "" {
+		return nil, fmt.Errorf("empty setting name")
+	}
+	if string(msg) == "null" {
+		return nil, nil
+	}
+	switch name[0] {
+	case 's':
+		var s string
+		err := json.Unmarshal(msg, &s)
+		return s, err
+	case 'i':
+		i, err := strconv.ParseInt(string(msg), 10, 32)
+		return int32(i), err
+	case 'f':
+		// Parse as float32 directly to avoid rounding twice.
+		f, err := strconv.ParseFloat(string(msg), 32)
+		return float32(f), err
+	}
+	return nil, fmt.Errorf("setting %q doesn't start with s, i or f", name)
+}
+
+// Origin is what the table of defaults knows about a setting.
+type Origin int
+
+const (
+	// UnknownOrigin settings aren't in the table, but the table isn't
+	// complete, so they may still be vanilla.
+	UnknownOrigin Origin = iota
+	// VanillaOrigin settings are in the table.
+	VanillaOrigin
+	// AddedOrigin settings aren't in the complete table, so a plugin
+	// added them.
+	AddedOrigin
+)
+
+var originNames = map[Origin]string{
+	UnknownOrigin: "unknown",
+	VanillaOrigin: "vanilla",
+	AddedOrigin:   "added",
+}
+
+func (o Origin) String() string {
+	if name, ok := originNames[o]; ok {
+		return name
+	}
+	return fmt.Sprintf("Origin(%d)", int(o))
+}
+
+// Default returns the vanilla value of the setting name and where the
+// setting comes from. The value is nil for settings that aren't
+// VanillaOrigin, and for string settings, whose values depend on the
+// language of the masters.
+func Default(name string) (any, Origin) {
+	table := defaults()
+	if v, ok := table.settings[esm.FoldID(name)]; ok {
+		return v, VanillaOrigin
+	}
+	if table.complete {
+		return nil, AddedOrigin
+	}
+	return nil, UnknownOrigin
+}
+
+// Override is a setting a plugin sets.
+type Override struct {
+	Name  string
+	Value any
+	// Default is the vanilla value, or nil if it isn't known.
+	Default any
+	Origin  Origin
+}
+
+// MatchesDefault reports whether the plugin sets the setting to its
+// vanilla value, which makes the override redundant. It is false when the
+// vanilla value isn't known.
+func (o Override) MatchesDefault() bool {
+	return o.Default != nil && o.Value == o.Default
+}
+
+// Overrides lists the settings that records, a plugin's records, set, in
+// the order they appear. Deleted settings are left out.
+func Overrides(records []*esm.Record, opts ...esm.ParseOption) ([]Override, error) {
+	out := []Override{}
+	for _, rec := range records {
+		if rec.Tag != GMST {
+			continue
+		}
+		s, err := ParseSetting(rec, opts...)
+		if err != nil {
+			return nil, fmt.Errorf("record at 0x%x: %w", rec.PluginOffset, err)
+		}
+		if s.Deleted() {
+			continue
+		}
+		v, err := s.Value()
+		if err != nil {
+			return nil, err
+		}
+		o := Override{Name: s.NAME.Value, Value: v}
+		o.Default, o.Origin = Default(o.Name)
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+// Deleted reports whether r deletes the setting, restoring the value of
+// an earlier plugin.
+func (r *SettingRecord) Deleted() bool {
+	return esm.Deleted(r.Unknown)
+}
@@ -0,0 +1,63 @@
+// GMST records contain game settings.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package gmst
+
+import (
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// GMST handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/GMST
+const GMST esm.RecordTag = "GMST"
+
+func init() {
+	esm.RegisterRecord(GMST, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		s, err := ParseSetting(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	})
+	esm.RegisterSubrecords(GMST,
+		&NAMEField{},
+		&STRVField{},
+		&INTVField{},
+		&FLTVField{},
+	)
+}
+
+// Value returns the setting's value as the type its name gives: a string,
+// an int32 or a float32. A string setting without STRV is empty.
+func (r *SettingRecord) Value() (any, error) {
+	if r.NAME == nil {
+		return nil, fmt.Errorf("NAME: %w", esm.ErrSubrecordNotFound)
+	}
+	name := r.NAME.Value
+	if name == "" {
+		return nil, fmt.Errorf("setting has no name")
+	}
+	switch name[0] {
+	case 's':
+		if r.INTV != nil || r.FLTV != nil {
+			return nil, fmt.Errorf("string setting %q has a number value", name)
+		}
+		if r.STRV == nil {
+			return "", nil
+		}
+		return r.STRV.Value, nil
+	case 'i':
+		if r.INTV == nil || r.STRV != nil || r.FLTV != nil {
+			return nil, fmt.Errorf("integer setting %q needs INTV alone", name)
+		}
+		return r.INTV.Value, nil
+	case 'f':
+		if r.FLTV == nil || r.STRV != nil || r.INTV != nil {
+			return nil, fmt.Errorf("float setting %q needs FLTV alone", name)
+		}
+		return r.FLTV.Value, nil
+	}
+	return nil, fmt.Errorf("setting %q doesn't start with s, i or f", name)
+}
@@ -0,0 +1,116 @@
+package gmst
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+func setting(t *testing.T, r *SettingRecord) *esm.Record {
+	rec, err := esm.Encode(r)
+	require.NoError(t, err)
+	return rec
+}
+
+func TestValue(t *testing.T) {
+	for _, tc := range []struct {
+		setting *SettingRecord
+		want    any
+	}{
+		{&SettingRecord{NAME: &NAMEField{Value: "sYes"}, STRV: &STRVField{Value: "Ja"}}, "Ja"},
+		{&SettingRecord{NAME: &NAMEField{Value: "sEmpty"}}, ""},
+		{&SettingRecord{NAME: &NAMEField{Value: "iMaxActivateDist"}, INTV: &INTVField{Value: 256}}, int32(256)},
+		{&SettingRecord{NAME: &NAMEField{Value: "fJumpMoveBase"}, FLTV: &FLTVField{Value: 0.5}}, float32(0.5)},
+	} {
+		v, err := tc.setting.Value()
+		require.NoError(t, err)
+		require.Equal(t, tc.want, v)
+	}
+
+	for _, bad := range []*SettingRecord{
+		{NAME: &NAMEField{Value: "sYes"}, INTV: &INTVField{}},
+		{NAME: &NAMEField{Value: "iMaxActivateDist"}, FLTV: &FLTVField{}},
+		{NAME: &NAMEField{Value: "fJumpMoveBase"}},
+		{NAME: &NAMEField{Value: "Setting"}},
+		{NAME: &NAMEField{}},
+	} {
+		_, err := bad.Value()
+		require.Error(t, err, bad.NAME.Value)
+	}
+	_, err := (&SettingRecord{INTV: &INTVField{}}).Value()
+	require.ErrorIs(t, err, esm.ErrSubrecordNotFound)
+}
+
+func TestDefaults(t *testing.T) {
+	v, origin := Default("imaxactivatedist")
+	require.Equal(t, VanillaOrigin, origin)
+	require.Equal(t, int32(192), v)
+	v, origin = Default("fEncumberedMoveEffect")
+	require.Equal(t, VanillaOrigin, origin)
+	require.Equal(t, float32(0.3), v)
+	// String values depend on the language of the masters.
+	v, origin = Default("sYes")
+	require.Equal(t, VanillaOrigin, origin)
+	require.Nil(t, v)
+	// The checked-in table is partial, so it can't tell an added setting
+	// from a vanilla one it lacks.
+	v, origin = Default("sNotASetting")
+	require.Equal(t, UnknownOrigin, origin)
+	require.Nil(t, v)
+	require.False(t, defaults().complete)
+
+	// A complete table knows which settings plugins added.
+	partial := defaults
+	defer func() { defaults = partial }()
+	defaults = func() defaultsTable {
+		return defaultsTable{complete: true, settings: map[string]any{"syes": nil}}
+	}
+	_, origin = Default("sNotASetting")
+	require.Equal(t, AddedOrigin, origin)
+	require.Equal(t, "added", origin.String())
+	defaults = partial
+
+	// Every entry decodes as the type its name gives.
+	for name, v := range defaults().settings {
+		switch name[0] {
+		case 's':
+			require.Nil(t, v, name)
+		case 'i':
+			require.IsType(t, int32(0), v, name)
+		case 'f':
+			require.IsType(t, float32(0), v, name)
+		default:
+			require.Fail(t, "setting doesn't start with s, i or f", name)
+		}
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	deleted := setting(t, &SettingRecord{NAME: &NAMEField{Value: "sNo"}})
+	deleted.Subrecords = append(deleted.Subrecords, &esm.Subrecord{Tag: esm.DELE, Data: []byte{0, 0, 0, 0}})
+	overrides, err := Overrides([]*esm.Record{
+		setting(t, &SettingRecord{NAME: &NAMEField{Value: "iMaxActivateDist"}, INTV: &INTVField{Value: 256}}),
+		{Tag: "STAT"},
+		setting(t, &SettingRecord{NAME: &NAMEField{Value: "fJumpMoveBase"}, FLTV: &FLTVField{Value: 0.5}}),
+		setting(t, &SettingRecord{NAME: &NAMEField{Value: "sMyModSetting"}, STRV: &STRVField{Value: "hi"}}),
+		deleted,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []Override{
+		{Name: "iMaxActivateDist", Value: int32(256), Default: int32(192), Origin: VanillaOrigin},
+		{Name: "fJumpMoveBase", Value: float32(0.5), Default: float32(0.5), Origin: VanillaOrigin},
+		{Name: "sMyModSetting", Value: "hi", Origin: UnknownOrigin},
+	}, overrides)
+	require.False(t, overrides[0].MatchesDefault())
+	require.True(t, overrides[1].MatchesDefault())
+	require.False(t, overrides[2].MatchesDefault())
+
+	_, err = Overrides([]*esm.Record{setting(t, &SettingRecord{NAME: &NAMEField{Value: "fJumpMoveBase"}})})
+	require.Error(t, err)
+
+	// Lenient parsing leaves a setting without NAME, which has no value.
+	unnamed := setting(t, &SettingRecord{INTV: &INTVField{Value: 1}})
+	_, err = Overrides([]*esm.Record{unnamed}, esm.WithLenient())
+	require.ErrorIs(t, err, esm.ErrSubrecordNotFound)
+}
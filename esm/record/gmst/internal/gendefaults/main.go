@@ -0,0 +1,58 @@
+// Command gendefaults writes the table of vanilla game settings that the
+// gmst package embeds, from the master files given in load order. String
+// settings are written without their values, which depend on the language
+// of the masters.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/gmst"
+)
+
+func main() {
+	out := flag.String("o", "defaults.json", "Output file.")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal("usage: gendefaults [-o defaults.json] Morrowind.esm [Tribunal.esm Bloodmoon.esm]")
+	}
+
+	plugins, err := esm.LoadPlugins(context.Background(), flag.Args(), esm.LoadOptions{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	settings := map[string]any{}
+	names := map[string]string{}
+	for _, p := range plugins {
+		overrides, err := gmst.Overrides(p.Records)
+		if err != nil {
+			log.Fatalf("%s: %v", p.Path, err)
+		}
+		for _, o := range overrides {
+			// Later masters win, under the name they give.
+			key := esm.FoldID(o.Name)
+			delete(settings, names[key])
+			names[key] = o.Name
+			if strings.HasPrefix(o.Name, "s") {
+				settings[o.Name] = nil
+			} else {
+				settings[o.Name] = o.Value
+			}
+		}
+	}
+
+	data, err := json.MarshalIndent(map[string]any{"complete": true, "settings": settings}, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, append(data, '\n'), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote %d settings to %s", len(settings), *out)
+}
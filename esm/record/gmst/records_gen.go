@@ -0,0 +1,112 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package gmst
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// SettingRecord is a game setting.
+type SettingRecord struct {
+	NAME *NAMEField
+	STRV *STRVField
+	INTV *INTVField
+	FLTV *FLTVField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// settingRecordFields lists the tag that starts each field of SettingRecord.
+var settingRecordFields = []esm.SubrecordTag{NAME, STRV, INTV, FLTV}
+
+func (r *SettingRecord) Tag() esm.RecordTag { return GMST }
+
+func (r *SettingRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.STRV); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.INTV); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FLTV); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseSetting builds a SettingRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseSetting(rec *esm.Record, opts ...esm.ParseOption) (*SettingRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != GMST {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseSettingRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseSettingRecord parses the SettingRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseSettingRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*SettingRecord, int, error) {
+	r := &SettingRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(settingRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.STRV != nil {
+				f.Keep(i)
+				break
+			}
+			r.STRV, err = esm.ParseField[STRVField](f, i)
+		case 2:
+			if r.INTV != nil {
+				f.Keep(i)
+				break
+			}
+			r.INTV, err = esm.ParseField[INTVField](f, i)
+		case 3:
+			if r.FLTV != nil {
+				f.Keep(i)
+				break
+			}
+			r.FLTV, err = esm.ParseField[FLTVField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
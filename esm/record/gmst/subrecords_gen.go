@@ -0,0 +1,167 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package gmst
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// String value.
+const STRV esm.SubrecordTag = "STRV"
+
+// String value.
+type STRVField struct{ Value string }
+
+func (t *STRVField) Tag() esm.SubrecordTag { return STRV }
+
+// Layout implements esm.Structured.
+func (t *STRVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *STRVField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	s.Value = util.DecodeString(sub.Data)
+
+	return nil
+}
+
+func (s *STRVField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode STRV: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: raw}, nil
+}
+
+// Float value.
+const FLTV esm.SubrecordTag = "FLTV"
+
+// Float value.
+type FLTVField struct{ Value float32 }
+
+func (t *FLTVField) Tag() esm.SubrecordTag { return FLTV }
+
+// Size implements esm.Sized.
+func (t *FLTVField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *FLTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "f32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *FLTVField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("FLTV must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = util.BytesToFloat32(sub.Data[0:4])
+	return nil
+}
+
+func (s *FLTVField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: util.Float32ToBytes(s.Value)}, nil
+}
+
+// Integer value.
+const INTV esm.SubrecordTag = "INTV"
+
+// Integer value.
+type INTVField struct {
+	Value int32
+}
+
+func (t *INTVField) Tag() esm.SubrecordTag { return INTV }
+
+// Size implements esm.Sized.
+func (t *INTVField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *INTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "i32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *INTVField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("INTV must be 4 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Value = int32(binary.LittleEndian.Uint32(d[0:4]))
+	return nil
+}
+
+func (s *INTVField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 4)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Value))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Setting name. Its first letter gives the type of the value: s for a string, i for an integer and f for a float.
+const NAME esm.SubrecordTag = "NAME"
+
+// Setting name. Its first letter gives the type of the value: s for a string, i for an integer and f for a float.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,36 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package gmst
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("FLTV", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FLTVField{Value: gentest.Float32(r)})
+		}
+	})
+	t.Run("INTV", func(t *testing.T) {
+		for range 32 {
+			s := &INTVField{}
+			s.Value = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("STRV", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &STRVField{Value: gentest.String(r, 64)})
+		}
+	})
+}
@@ -0,0 +1,28 @@
+// INGR records contain alchemy ingredients.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package ingr
+
+import "github.com/ernmw/omwpacker/esm"
+
+// INGR handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/INGR
+const INGR esm.RecordTag = "INGR"
+
+func init() {
+	esm.RegisterRecord(INGR, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		i, err := ParseIngredient(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return i, nil
+	})
+	esm.RegisterSubrecords(INGR,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&IRDTField{},
+		&SCRIField{},
+		&ITEXField{},
+	)
+}
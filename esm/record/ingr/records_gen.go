@@ -0,0 +1,132 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package ingr
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// IngredientRecord is an alchemy ingredient.
+type IngredientRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	IRDT *IRDTField
+	SCRI *SCRIField
+	ITEX *ITEXField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// ingredientRecordFields lists the tag that starts each field of IngredientRecord.
+var ingredientRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, IRDT, SCRI, ITEX}
+
+func (r *IngredientRecord) Tag() esm.RecordTag { return INGR }
+
+func (r *IngredientRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.IRDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ITEX); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseIngredient builds a IngredientRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseIngredient(rec *esm.Record, opts ...esm.ParseOption) (*IngredientRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != INGR {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseIngredientRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseIngredientRecord parses the IngredientRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseIngredientRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*IngredientRecord, int, error) {
+	r := &IngredientRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(ingredientRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.IRDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.IRDT, err = esm.ParseField[IRDTField](f, i)
+		case 4:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 5:
+			if r.ITEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.ITEX, err = esm.ParseField[ITEXField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
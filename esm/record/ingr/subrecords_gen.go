@@ -0,0 +1,298 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package ingr
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Inventory icon filename.
+const ITEX esm.SubrecordTag = "ITEX"
+
+// Inventory icon filename.
+type ITEXField struct{ Value string }
+
+func (t *ITEXField) Tag() esm.SubrecordTag { return ITEX }
+
+// Layout implements esm.Structured.
+func (t *ITEXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ITEXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ITEXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ITEX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Ingredient data.
+const IRDT esm.SubrecordTag = "IRDT"
+
+// Ingredient data.
+type IRDTField struct {
+	Weight float32
+	Value  int32
+	// Magic effect indices, or -1.
+	Effects [4]int32
+	// Skills the effects affect, or -1.
+	Skills [4]int32
+	// Attributes the effects affect, or -1.
+	Attributes [4]int32
+}
+
+func (t *IRDTField) Tag() esm.SubrecordTag { return IRDT }
+
+// Size implements esm.Sized.
+func (t *IRDTField) Size() int { return 56 }
+
+// Layout implements esm.Structured.
+func (t *IRDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Weight", Type: "f32", Offset: 0, Size: 4},
+		{Name: "Value", Type: "i32", Offset: 4, Size: 4},
+		{Name: "Effects", Type: "i32", Offset: 8, Size: 16, Count: 4},
+		{Name: "Skills", Type: "i32", Offset: 24, Size: 16, Count: 4},
+		{Name: "Attributes", Type: "i32", Offset: 40, Size: 16, Count: 4},
+	}
+}
+
+func (s *IRDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 56 {
+		return fmt.Errorf("IRDT must be 56 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Weight = math.Float32frombits(binary.LittleEndian.Uint32(d[0:4]))
+	s.Value = int32(binary.LittleEndian.Uint32(d[4:8]))
+	for i := range s.Effects {
+		s.Effects[i] = int32(binary.LittleEndian.Uint32(d[8+i*4 : 8+(i+1)*4]))
+	}
+	for i := range s.Skills {
+		s.Skills[i] = int32(binary.LittleEndian.Uint32(d[24+i*4 : 24+(i+1)*4]))
+	}
+	for i := range s.Attributes {
+		s.Attributes[i] = int32(binary.LittleEndian.Uint32(d[40+i*4 : 40+(i+1)*4]))
+	}
+	return nil
+}
+
+func (s *IRDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 56)
+	binary.LittleEndian.PutUint32(d[0:4], math.Float32bits(float32(s.Weight)))
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.Value))
+	for i := range s.Effects {
+		binary.LittleEndian.PutUint32(d[8+i*4:8+(i+1)*4], uint32(s.Effects[i]))
+	}
+	for i := range s.Skills {
+		binary.LittleEndian.PutUint32(d[24+i*4:24+(i+1)*4], uint32(s.Skills[i]))
+	}
+	for i := range s.Attributes {
+		binary.LittleEndian.PutUint32(d[40+i*4:40+(i+1)*4], uint32(s.Attributes[i]))
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Ingredient name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Ingredient name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Ingredient ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Ingredient ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,18 @@
+// Package item contains the subrecords and groups that several item
+// records share. It registers nothing itself; the record packages do.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package item
+
+import "github.com/ernmw/omwpacker/esm"
+
+// Subrecords returns a prototype of every shared subrecord, for record
+// packages to pass to esm.RegisterSubrecords along with their own.
+func Subrecords() []esm.ParsedSubrecord {
+	return []esm.ParsedSubrecord{
+		&INDXField{},
+		&BNAMField{},
+		&CNAMField{},
+	}
+}
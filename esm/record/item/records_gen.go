@@ -0,0 +1,85 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package item
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// Part is a body part slot that armor or clothing covers, with the body parts that show there.
+type Part struct {
+	INDX *INDXField
+	BNAM *BNAMField
+	CNAM *CNAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// partFields lists the tag that starts each field of Part.
+var partFields = []esm.SubrecordTag{INDX, BNAM, CNAM}
+
+func (r *Part) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.INDX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.BNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNAM); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParsePart parses the Part starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+// Records of other packages use it to parse their Part fields.
+func ParsePart(rec *esm.Record, start int, o *esm.ParseOptions) (*Part, int, error) {
+	r := &Part{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(partFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.INDX != nil {
+				break fields
+			}
+			r.INDX, err = esm.ParseField[INDXField](f, i)
+		case 1:
+			if r.BNAM != nil {
+				break fields
+			}
+			r.BNAM, err = esm.ParseField[BNAMField](f, i)
+		case 2:
+			if r.CNAM != nil {
+				break fields
+			}
+			r.CNAM, err = esm.ParseField[CNAMField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.INDX == nil {
+		if err := f.Missing(INDX); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
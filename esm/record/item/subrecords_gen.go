@@ -0,0 +1,328 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package item
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Body part ID used for female characters.
+const CNAM esm.SubrecordTag = "CNAM"
+
+// Body part ID used for female characters.
+type CNAMField struct{ Value string }
+
+func (t *CNAMField) Tag() esm.SubrecordTag { return CNAM }
+
+// Layout implements esm.Structured.
+func (t *CNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *CNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Body part ID used for male characters.
+const BNAM esm.SubrecordTag = "BNAM"
+
+// Body part ID used for male characters.
+type BNAMField struct{ Value string }
+
+func (t *BNAMField) Tag() esm.SubrecordTag { return BNAM }
+
+// Layout implements esm.Structured.
+func (t *BNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *BNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *BNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode BNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// BodyPart is the value of a INDX subrecord.
+type BodyPart uint8
+
+const (
+	HeadPart          BodyPart = 0
+	HairPart          BodyPart = 1
+	NeckPart          BodyPart = 2
+	CuirassPart       BodyPart = 3
+	GroinPart         BodyPart = 4
+	SkirtPart         BodyPart = 5
+	RightHandPart     BodyPart = 6
+	LeftHandPart      BodyPart = 7
+	RightWristPart    BodyPart = 8
+	LeftWristPart     BodyPart = 9
+	ShieldPart        BodyPart = 10
+	RightForearmPart  BodyPart = 11
+	LeftForearmPart   BodyPart = 12
+	RightUpperArmPart BodyPart = 13
+	LeftUpperArmPart  BodyPart = 14
+	RightFootPart     BodyPart = 15
+	LeftFootPart      BodyPart = 16
+	RightAnklePart    BodyPart = 17
+	LeftAnklePart     BodyPart = 18
+	RightKneePart     BodyPart = 19
+	LeftKneePart      BodyPart = 20
+	RightUpperLegPart BodyPart = 21
+	LeftUpperLegPart  BodyPart = 22
+	RightPauldronPart BodyPart = 23
+	LeftPauldronPart  BodyPart = 24
+	WeaponPart        BodyPart = 25
+	TailPart          BodyPart = 26
+)
+
+func (e BodyPart) String() string {
+	switch e {
+	case HeadPart:
+		return "Head"
+	case HairPart:
+		return "Hair"
+	case NeckPart:
+		return "Neck"
+	case CuirassPart:
+		return "Cuirass"
+	case GroinPart:
+		return "Groin"
+	case SkirtPart:
+		return "Skirt"
+	case RightHandPart:
+		return "RightHand"
+	case LeftHandPart:
+		return "LeftHand"
+	case RightWristPart:
+		return "RightWrist"
+	case LeftWristPart:
+		return "LeftWrist"
+	case ShieldPart:
+		return "Shield"
+	case RightForearmPart:
+		return "RightForearm"
+	case LeftForearmPart:
+		return "LeftForearm"
+	case RightUpperArmPart:
+		return "RightUpperArm"
+	case LeftUpperArmPart:
+		return "LeftUpperArm"
+	case RightFootPart:
+		return "RightFoot"
+	case LeftFootPart:
+		return "LeftFoot"
+	case RightAnklePart:
+		return "RightAnkle"
+	case LeftAnklePart:
+		return "LeftAnkle"
+	case RightKneePart:
+		return "RightKnee"
+	case LeftKneePart:
+		return "LeftKnee"
+	case RightUpperLegPart:
+		return "RightUpperLeg"
+	case LeftUpperLegPart:
+		return "LeftUpperLeg"
+	case RightPauldronPart:
+		return "RightPauldron"
+	case LeftPauldronPart:
+		return "LeftPauldron"
+	case WeaponPart:
+		return "Weapon"
+	case TailPart:
+		return "Tail"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e BodyPart) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *BodyPart) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Head":
+		*e = HeadPart
+		return nil
+	case "Hair":
+		*e = HairPart
+		return nil
+	case "Neck":
+		*e = NeckPart
+		return nil
+	case "Cuirass":
+		*e = CuirassPart
+		return nil
+	case "Groin":
+		*e = GroinPart
+		return nil
+	case "Skirt":
+		*e = SkirtPart
+		return nil
+	case "RightHand":
+		*e = RightHandPart
+		return nil
+	case "LeftHand":
+		*e = LeftHandPart
+		return nil
+	case "RightWrist":
+		*e = RightWristPart
+		return nil
+	case "LeftWrist":
+		*e = LeftWristPart
+		return nil
+	case "Shield":
+		*e = ShieldPart
+		return nil
+	case "RightForearm":
+		*e = RightForearmPart
+		return nil
+	case "LeftForearm":
+		*e = LeftForearmPart
+		return nil
+	case "RightUpperArm":
+		*e = RightUpperArmPart
+		return nil
+	case "LeftUpperArm":
+		*e = LeftUpperArmPart
+		return nil
+	case "RightFoot":
+		*e = RightFootPart
+		return nil
+	case "LeftFoot":
+		*e = LeftFootPart
+		return nil
+	case "RightAnkle":
+		*e = RightAnklePart
+		return nil
+	case "LeftAnkle":
+		*e = LeftAnklePart
+		return nil
+	case "RightKnee":
+		*e = RightKneePart
+		return nil
+	case "LeftKnee":
+		*e = LeftKneePart
+		return nil
+	case "RightUpperLeg":
+		*e = RightUpperLegPart
+		return nil
+	case "LeftUpperLeg":
+		*e = LeftUpperLegPart
+		return nil
+	case "RightPauldron":
+		*e = RightPauldronPart
+		return nil
+	case "LeftPauldron":
+		*e = LeftPauldronPart
+		return nil
+	case "Weapon":
+		*e = WeaponPart
+		return nil
+	case "Tail":
+		*e = TailPart
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*1)
+	if err != nil {
+		return fmt.Errorf("unknown BodyPart %q", text)
+	}
+	*e = BodyPart(v)
+	return nil
+}
+
+// Body part slot the item covers.
+const INDX esm.SubrecordTag = "INDX"
+
+// Body part slot the item covers.
+type INDXField struct{ Value BodyPart }
+
+func (t *INDXField) Tag() esm.SubrecordTag { return INDX }
+
+// Size implements esm.Sized.
+func (t *INDXField) Size() int { return 1 }
+
+// Layout implements esm.Structured.
+func (t *INDXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
+	}
+}
+
+func (s *INDXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 1 {
+		return fmt.Errorf("INDX must be 1 bytes, got %d", len(sub.Data))
+	}
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, &s.Value)
+	return err
+}
+
+func (s *INDXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := binary.Append(nil, binary.LittleEndian, s.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
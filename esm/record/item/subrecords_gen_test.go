@@ -0,0 +1,29 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package item
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("BNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &BNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("BodyPart", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &INDXField{Value: BodyPart(r.Uint64())})
+		}
+	})
+	t.Run("CNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+}
@@ -0,0 +1,48 @@
+package land
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestDataFlags(t *testing.T) {
+	f := HeightsNormalsFlag | TexturesFlag | 0x100
+	require.True(t, f.Has(TexturesFlag))
+	require.False(t, f.Has(ColorsFlag|TexturesFlag))
+	require.Equal(t, "HeightsNormals|Textures|0x100", f.String())
+	require.Equal(t, "0", DataFlags(0).String())
+
+	f.Set(ColorsFlag, true)
+	f.Set(HeightsNormalsFlag, false)
+	require.Equal(t, ColorsFlag|TexturesFlag|0x100, f)
+
+	raw, err := json.Marshal(&DATAField{Value: f})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Value": ["Colors", "Textures", "0x100"]}`, string(raw))
+	var fromJSON DATAField
+	require.NoError(t, json.Unmarshal(raw, &fromJSON))
+	require.Equal(t, f, fromJSON.Value)
+	require.NoError(t, json.Unmarshal([]byte(`{"Value": 7}`), &fromJSON))
+	require.Equal(t, HeightsNormalsFlag|ColorsFlag|TexturesFlag, fromJSON.Value)
+	require.Error(t, json.Unmarshal([]byte(`{"Value": ["Nope"]}`), &fromJSON))
+
+	out, err := yaml.Marshal(map[string]DataFlags{"flags": f})
+	require.NoError(t, err)
+	var fromYAML map[string]DataFlags
+	require.NoError(t, yaml.Unmarshal(out, &fromYAML))
+	require.Equal(t, f, fromYAML["flags"])
+	require.NoError(t, yaml.Unmarshal([]byte("flags: 2\n"), &fromYAML))
+	require.Equal(t, ColorsFlag, fromYAML["flags"])
+
+	sub, err := (&DATAField{Value: f}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x06, 0x01, 0, 0}, sub.Data)
+	var parsed DATAField
+	require.NoError(t, sub.UnmarshalTo(&parsed))
+	require.Equal(t, f, parsed.Value)
+	require.Error(t, (&esm.Subrecord{Tag: DATA, Data: []byte{1}}).UnmarshalTo(&parsed))
+}
@@ -12,3 +12,22 @@ import "github.com/ernmw/omwpacker/esm"
 
 // LAND handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/LAND
 const LAND esm.RecordTag = "LAND"
+
+func init() {
+	esm.RegisterIdentity(LAND, func(rec *esm.Record) (string, error) {
+		intv := &INTVField{}
+		if err := rec.UnmarshalFirst(intv); err != nil {
+			return "", err
+		}
+		return esm.GridKey(intv.X, intv.Y), nil
+	})
+	esm.RegisterSubrecords(LAND,
+		&INTVField{},
+		&DATAField{},
+		&VHGTField{},
+		&VNMLField{},
+		&VCLRField{},
+		&VTEXField{},
+		&WNAMField{},
+	)
+}
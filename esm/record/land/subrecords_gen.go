@@ -4,6 +4,10 @@ package land
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ernmw/omwpacker/esm"
 )
@@ -16,10 +20,24 @@ type INTVField struct{ X, Y int32 }
 
 func (t *INTVField) Tag() esm.SubrecordTag { return INTV }
 
+// Size implements esm.Sized.
+func (t *INTVField) Size() int { return 8 }
+
+// Layout implements esm.Structured.
+func (t *INTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "X", Type: "i32", Offset: 0, Size: 4},
+		{Name: "Y", Type: "i32", Offset: 4, Size: 4},
+	}
+}
+
 func (s *INTVField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if len(sub.Data) != 8 {
+		return fmt.Errorf("INTV must be 8 bytes, got %d", len(sub.Data))
+	}
 	s.X = int32(binary.LittleEndian.Uint32(sub.Data[0:4]))
 	s.Y = int32(binary.LittleEndian.Uint32(sub.Data[4:8]))
 	return nil
@@ -41,31 +59,168 @@ func (s *INTVField) Marshal() (*esm.Subrecord, error) {
 	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
 }
 
-// Data types included. If the relevant bit isn't set, the related fields will not be loaded, even if present. 0x01 = Includes VNML, VHGT and WNAM. 0x02 = Includes VCLR. 0x04 = Includes VTEX.
+// DataFlags holds the bits of a DATA subrecord.
+type DataFlags uint32
+
+const (
+	// Includes VNML, VHGT and WNAM.
+	HeightsNormalsFlag DataFlags = 0x01
+	// Includes VCLR.
+	ColorsFlag DataFlags = 0x02
+	// Includes VTEX.
+	TexturesFlag DataFlags = 0x04
+)
+
+// dataFlagsNames lists the named bits of DataFlags, in order.
+var dataFlagsNames = []struct {
+	flag DataFlags
+	name string
+}{
+	{HeightsNormalsFlag, "HeightsNormals"},
+	{ColorsFlag, "Colors"},
+	{TexturesFlag, "Textures"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f DataFlags) Has(flag DataFlags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *DataFlags) Set(flag DataFlags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f DataFlags) Names() []string {
+	names := []string{}
+	for _, n := range dataFlagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f DataFlags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseDataFlags combines bit names, as returned by Names, into a DataFlags.
+// Numbers are accepted too.
+func ParseDataFlags(names []string) (DataFlags, error) {
+	var f DataFlags
+next:
+	for _, name := range names {
+		for _, n := range dataFlagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown DataFlags %q", name)
+		}
+		f |= DataFlags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f DataFlags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *DataFlags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = DataFlags(v)
+		return nil
+	}
+	v, err := ParseDataFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f DataFlags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *DataFlags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = DataFlags(v)
+		return nil
+	}
+	v, err := ParseDataFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// Data types included. If the relevant bit isn't set, the related fields will not be loaded, even if present.
 const DATA esm.SubrecordTag = "DATA"
 
-// Data types included. If the relevant bit isn't set, the related fields will not be loaded, even if present. 0x01 = Includes VNML, VHGT and WNAM. 0x02 = Includes VCLR. 0x04 = Includes VTEX.
-type DATAField struct{ Value uint32 }
+// Data types included. If the relevant bit isn't set, the related fields will not be loaded, even if present.
+type DATAField struct{ Value DataFlags }
 
 func (t *DATAField) Tag() esm.SubrecordTag { return DATA }
 
+// Size implements esm.Sized.
+func (t *DATAField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *DATAField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
 func (s *DATAField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
-	return nil
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("DATA must be 4 bytes, got %d", len(sub.Data))
+	}
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, &s.Value)
+	return err
 }
 
 func (s *DATAField) Marshal() (*esm.Subrecord, error) {
 	if s == nil {
 		return nil, nil
 	}
-
-	buff := new(bytes.Buffer)
-
-	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+	data, err := binary.Append(nil, binary.LittleEndian, s.Value)
+	if err != nil {
 		return nil, err
 	}
-	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
 }
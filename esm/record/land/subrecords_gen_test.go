@@ -0,0 +1,24 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package land
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("DataFlags", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DATAField{Value: DataFlags(r.Uint64())})
+		}
+	})
+	t.Run("INTV", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &INTVField{X: int32(r.Uint32()), Y: int32(r.Uint32())})
+		}
+	})
+}
@@ -27,6 +27,9 @@ type VCLRField struct {
 
 func (s *VCLRField) Tag() esm.SubrecordTag { return VCLR }
 
+// Size implements esm.Sized.
+func (s *VCLRField) Size() int { return 3 * vclrSize * vclrSize }
+
 func (s *VCLRField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
@@ -22,14 +22,22 @@ type VHGTField struct {
 	// Thus a pixel value of 0 means it has the same height as the last pixel.
 	// Note that the Y-direction of the data is from the bottom up.
 	Heights [][]int8
+	// Trailing bytes of unknown purpose, kept so the field round-trips.
+	Unknown [3]byte
 }
 
 func (s *VHGTField) Tag() esm.SubrecordTag { return VHGT }
 
+// Size implements esm.Sized.
+func (s *VHGTField) Size() int { return 4 + vhgtSize*vhgtSize + 3 }
+
 func (s *VHGTField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if want := s.Size(); len(sub.Data) != want {
+		return fmt.Errorf("VHGT must be %d bytes, got %d", want, len(sub.Data))
+	}
 	s.Offset = util.BytesToFloat32(sub.Data[0:4])
 	var err error
 
@@ -41,6 +49,7 @@ func (s *VHGTField) Unmarshal(sub *esm.Subrecord) error {
 	if err != nil {
 		return fmt.Errorf("slice as grid: %w", err)
 	}
+	copy(s.Unknown[:], sub.Data[len(sub.Data)-3:])
 	return nil
 }
 
@@ -62,8 +71,7 @@ func (s *VHGTField) Marshal() (*esm.Subrecord, error) {
 		return nil, fmt.Errorf("bytes from slice: %w", err)
 	}
 	copy(outBuff[4:], outData[:])
-
-	// last 3 bytes are junk
+	copy(outBuff[4+gridSize:], s.Unknown[:])
 
 	return &esm.Subrecord{Tag: s.Tag(), Data: outBuff}, nil
 }
@@ -27,6 +27,9 @@ type VNMLField struct {
 
 func (s *VNMLField) Tag() esm.SubrecordTag { return VNML }
 
+// Size implements esm.Sized.
+func (s *VNMLField) Size() int { return 3 * vnmlSize * vnmlSize }
+
 func (s *VNMLField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
@@ -19,6 +19,9 @@ type VTEXField struct {
 
 func (s *VTEXField) Tag() esm.SubrecordTag { return VTEX }
 
+// Size implements esm.Sized.
+func (s *VTEXField) Size() int { return 2 * vtexSize * vtexSize }
+
 func (s *VTEXField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
@@ -19,10 +19,16 @@ type WNAMField struct {
 
 func (s *WNAMField) Tag() esm.SubrecordTag { return WNAM }
 
+// Size implements esm.Sized.
+func (s *WNAMField) Size() int { return wnamSize * wnamSize }
+
 func (s *WNAMField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if want := s.Size(); len(sub.Data) != want {
+		return fmt.Errorf("WNAM must be %d bytes, got %d", want, len(sub.Data))
+	}
 	var err error
 	s.Heights, err = util.SliceAsGrid(wnamSize, sub.Data)
 	if err != nil {
@@ -0,0 +1,196 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package levc
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/leveled"
+)
+
+// CreatureListRecord is a leveled list of creatures.
+type CreatureListRecord struct {
+	NAME *NAMEField
+	DATA *DATAField
+	NNAM *leveled.NNAMField
+	INDX *leveled.INDXField
+	// Entries, usually in level order.
+	Entries []*Entry
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// creatureListRecordFields lists the tag that starts each field of CreatureListRecord.
+var creatureListRecordFields = []esm.SubrecordTag{NAME, DATA, leveled.NNAM, leveled.INDX, CNAM}
+
+func (r *CreatureListRecord) Tag() esm.RecordTag { return LEVC }
+
+func (r *CreatureListRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DATA); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.NNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.INDX); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Entries {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseCreatureList builds a CreatureListRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseCreatureList(rec *esm.Record, opts ...esm.ParseOption) (*CreatureListRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != LEVC {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseCreatureListRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseCreatureListRecord parses the CreatureListRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseCreatureListRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*CreatureListRecord, int, error) {
+	r := &CreatureListRecord{
+		Entries: []*Entry{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(creatureListRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.DATA != nil {
+				f.Keep(i)
+				break
+			}
+			r.DATA, err = esm.ParseField[DATAField](f, i)
+		case 2:
+			if r.NNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.NNAM, err = esm.ParseField[leveled.NNAMField](f, i)
+		case 3:
+			if r.INDX != nil {
+				f.Keep(i)
+				break
+			}
+			r.INDX, err = esm.ParseField[leveled.INDXField](f, i)
+		case 4:
+			var g *Entry
+			if g, consumed, err = parseEntry(rec, i, o); err == nil {
+				r.Entries = append(r.Entries, g)
+			}
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
+
+// Entry is a creature the list can give, from a player level on.
+type Entry struct {
+	CNAM *CNAMField
+	INTV *leveled.INTVField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// entryFields lists the tag that starts each field of Entry.
+var entryFields = []esm.SubrecordTag{CNAM, leveled.INTV}
+
+func (r *Entry) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.CNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.INTV); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// parseEntry parses the Entry starting at rec.Subrecords[start].
+// It stops before a tag it doesn't know, or whose field is already set.
+func parseEntry(rec *esm.Record, start int, o *esm.ParseOptions) (*Entry, int, error) {
+	r := &Entry{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+fields:
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(entryFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.CNAM != nil {
+				break fields
+			}
+			r.CNAM, err = esm.ParseField[CNAMField](f, i)
+		case 1:
+			if r.INTV != nil {
+				break fields
+			}
+			r.INTV, err = esm.ParseField[leveled.INTVField](f, i)
+		default:
+			break fields
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.CNAM == nil {
+		if err := f.Missing(CNAM); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
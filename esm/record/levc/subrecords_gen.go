@@ -0,0 +1,259 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package levc
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Creature ID.
+const CNAM esm.SubrecordTag = "CNAM"
+
+// Creature ID.
+type CNAMField struct{ Value string }
+
+func (t *CNAMField) Tag() esm.SubrecordTag { return CNAM }
+
+// Layout implements esm.Structured.
+func (t *CNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *CNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Flags holds the bits of a DATA subrecord.
+type Flags uint32
+
+const (
+	// Picks from every entry up to the player's level, not just the highest.
+	AllLevelsFlag Flags = 0x01
+)
+
+// flagsNames lists the named bits of Flags, in order.
+var flagsNames = []struct {
+	flag Flags
+	name string
+}{
+	{AllLevelsFlag, "AllLevels"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f Flags) Has(flag Flags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *Flags) Set(flag Flags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f Flags) Names() []string {
+	names := []string{}
+	for _, n := range flagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseFlags combines bit names, as returned by Names, into a Flags.
+// Numbers are accepted too.
+func ParseFlags(names []string) (Flags, error) {
+	var f Flags
+next:
+	for _, name := range names {
+		for _, n := range flagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown Flags %q", name)
+		}
+		f |= Flags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f Flags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *Flags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f Flags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *Flags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// List flags.
+const DATA esm.SubrecordTag = "DATA"
+
+// List flags.
+type DATAField struct{ Value Flags }
+
+func (t *DATAField) Tag() esm.SubrecordTag { return DATA }
+
+// Size implements esm.Sized.
+func (t *DATAField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *DATAField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *DATAField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("DATA must be 4 bytes, got %d", len(sub.Data))
+	}
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, &s.Value)
+	return err
+}
+
+func (s *DATAField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := binary.Append(nil, binary.LittleEndian, s.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
+
+// Leveled creature list ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Leveled creature list ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,29 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package levc
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("CNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Flags", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DATAField{Value: Flags(r.Uint64())})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+}
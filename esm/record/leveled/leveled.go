@@ -0,0 +1,41 @@
+// Package leveled contains the subrecords that leveled item and creature
+// lists share, and merges the lists of a load order. It registers nothing
+// itself; the record packages do.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+package leveled
+
+import "github.com/ernmw/omwpacker/esm"
+
+// Subrecords returns a prototype of every shared subrecord, for record
+// packages to pass to esm.RegisterSubrecords along with their own.
+func Subrecords() []esm.ParsedSubrecord {
+	return []esm.ParsedSubrecord{
+		&NNAMField{},
+		&INDXField{},
+		&INTVField{},
+	}
+}
+
+// Entry is an object a leveled list can give, from a player level on.
+type Entry struct {
+	ID    string
+	Level uint16
+}
+
+// List is the part of a leveled list record that merging changes.
+type List struct {
+	Flags uint32
+	// ChanceNone is the chance in percent that the list gives nothing.
+	ChanceNone uint8
+	Entries    []Entry
+}
+
+// Record is a leveled list record.
+type Record interface {
+	esm.ParsedRecord
+	// List returns the record's list.
+	List() *List
+	// SetList replaces the record's list, and its entry count.
+	SetList(l *List)
+}
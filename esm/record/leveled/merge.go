@@ -0,0 +1,170 @@
+package leveled
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// Tags are the tags of leveled list records.
+var Tags = []esm.RecordTag{"LEVI", "LEVC"}
+
+// entryKey identifies entries that give the same object at the same level.
+type entryKey struct {
+	id    string
+	level uint16
+}
+
+func keyOf(e Entry) entryKey {
+	return entryKey{id: esm.FoldID(e.ID), level: e.Level}
+}
+
+func countEntries(entries []Entry) map[entryKey]int {
+	counts := map[entryKey]int{}
+	for _, e := range entries {
+		counts[keyOf(e)]++
+	}
+	return counts
+}
+
+// Merge combines versions of a list, given in load order, relative to the
+// first. Each later version's additions and removals are kept: an entry
+// ends up as many times as the base has it, plus the most any version
+// adds, minus the most any version removes, so versions that carry each
+// other's changes don't double them. Base entries keep their order and
+// additions follow in load order. The flags and chance of the last
+// version that changes them win.
+func Merge(versions []*List) *List {
+	if len(versions) == 0 {
+		return nil
+	}
+	base := versions[0]
+	merged := &List{Flags: base.Flags, ChanceNone: base.ChanceNone}
+	for _, v := range versions[1:] {
+		if v.Flags != base.Flags {
+			merged.Flags = v.Flags
+		}
+		if v.ChanceNone != base.ChanceNone {
+			merged.ChanceNone = v.ChanceNone
+		}
+	}
+
+	baseCounts := countEntries(base.Entries)
+	added, removed := map[entryKey]int{}, map[entryKey]int{}
+	for _, v := range versions[1:] {
+		counts := countEntries(v.Entries)
+		for k, n := range counts {
+			added[k] = max(added[k], n-baseCounts[k])
+		}
+		for k, n := range baseCounts {
+			removed[k] = max(removed[k], n-counts[k])
+		}
+	}
+
+	want := map[entryKey]int{}
+	for _, v := range versions {
+		for _, e := range v.Entries {
+			k := keyOf(e)
+			want[k] = max(0, baseCounts[k]+added[k]-removed[k])
+		}
+	}
+	have := map[entryKey]int{}
+	merged.Entries = []Entry{}
+	for _, v := range versions {
+		for _, e := range v.Entries {
+			if k := keyOf(e); have[k] < want[k] {
+				have[k]++
+				merged.Entries = append(merged.Entries, e)
+			}
+		}
+	}
+	return merged
+}
+
+// Equal reports whether l and other have the same flags, chance and
+// entries, in the same order.
+func (l *List) Equal(other *List) bool {
+	return l.Flags == other.Flags && l.ChanceNone == other.ChanceNone &&
+		slices.EqualFunc(l.Entries, other.Entries, func(a, b Entry) bool {
+			return keyOf(a) == keyOf(b)
+		})
+}
+
+// MergeLoadOrder merges the leveled lists of plugins, which are in load
+// order. It returns a record for every list that more than one plugin
+// has, and whose merged version differs from the one the last plugin
+// gives, in the order the lists first appear. The records are the last
+// plugin's, with the merged list sorted by level. Lists the last plugin
+// deletes stay deleted, and a list re-created after a deletion merges
+// only the versions from then on. The record packages of Tags must be registered.
+func MergeLoadOrder(plugins []*esm.Plugin, opts ...esm.ParseOption) ([]*esm.Record, error) {
+	type versions struct {
+		records []Record
+		deleted bool
+	}
+	order := []esm.ID{}
+	lists := map[esm.ID]*versions{}
+	for _, p := range plugins {
+		var identifier esm.Identifier
+		for _, rec := range p.Records {
+			if !slices.Contains(Tags, rec.Tag) {
+				continue
+			}
+			id, err := identifier.Identify(rec)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", p.Path, err)
+			}
+			parsed, err := esm.Decode(rec, opts...)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s %q: %w", p.Path, rec.Tag, id.Key, err)
+			}
+			list, ok := parsed.(Record)
+			if !ok {
+				continue
+			}
+			v := lists[id]
+			if v == nil {
+				v = &versions{}
+				lists[id] = v
+				order = append(order, id)
+			}
+			// A list re-created after a deletion starts over from the new
+			// record.
+			v.deleted = rec.Deleted()
+			if v.deleted {
+				v.records = nil
+			} else {
+				v.records = append(v.records, list)
+			}
+		}
+	}
+
+	out := []*esm.Record{}
+	for _, id := range order {
+		v := lists[id]
+		if v.deleted || len(v.records) < 2 {
+			continue
+		}
+		all := make([]*List, len(v.records))
+		for i, r := range v.records {
+			all[i] = r.List()
+		}
+		merged := Merge(all)
+		last := v.records[len(v.records)-1]
+		if merged.Equal(last.List()) {
+			continue
+		}
+		// The game stops reading a list at the first entry above the
+		// player's level, so entries go in level order.
+		slices.SortStableFunc(merged.Entries, func(a, b Entry) int { return cmp.Compare(a.Level, b.Level) })
+		last.SetList(merged)
+		rec, err := esm.Encode(last)
+		if err != nil {
+			return nil, fmt.Errorf("%s %q: %w", id.Tag, id.Key, err)
+		}
+		out = append(out, rec)
+	}
+	return out, nil
+}
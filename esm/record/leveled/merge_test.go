@@ -0,0 +1,62 @@
+package leveled
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func entries(ids ...string) []Entry {
+	out := []Entry{}
+	for _, id := range ids {
+		out = append(out, Entry{ID: id, Level: 1})
+	}
+	return out
+}
+
+func TestMerge(t *testing.T) {
+	base := &List{ChanceNone: 10, Entries: entries("dagger", "sword", "sword", "axe")}
+	for _, tc := range []struct {
+		name     string
+		versions []*List
+		want     *List
+	}{{
+		name: "additions from every plugin",
+		versions: []*List{base,
+			{ChanceNone: 10, Entries: entries("dagger", "sword", "sword", "axe", "club")},
+			{ChanceNone: 10, Entries: entries("dagger", "sword", "sword", "axe", "spear")},
+		},
+		want: &List{ChanceNone: 10, Entries: entries("dagger", "sword", "sword", "axe", "club", "spear")},
+	}, {
+		name: "removals survive later plugins",
+		versions: []*List{base,
+			{ChanceNone: 10, Entries: entries("dagger", "sword", "axe")},
+			{ChanceNone: 10, Entries: entries("dagger", "sword", "sword", "axe", "club")},
+		},
+		want: &List{ChanceNone: 10, Entries: entries("dagger", "sword", "axe", "club")},
+	}, {
+		name: "shared additions count once",
+		versions: []*List{base,
+			{ChanceNone: 10, Entries: entries("dagger", "sword", "sword", "axe", "CLUB")},
+			{ChanceNone: 10, Entries: entries("club", "dagger", "sword", "sword", "axe", "spear")},
+		},
+		want: &List{ChanceNone: 10, Entries: entries("dagger", "sword", "sword", "axe", "CLUB", "spear")},
+	}, {
+		name: "last change to the header wins",
+		versions: []*List{base,
+			{Flags: 2, ChanceNone: 50, Entries: base.Entries},
+			{Flags: 1, ChanceNone: 10, Entries: base.Entries},
+		},
+		want: &List{Flags: 1, ChanceNone: 50, Entries: base.Entries},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Merge(tc.versions)
+			require.True(t, tc.want.Equal(got), "got %+v", got)
+		})
+	}
+
+	// Levels are part of an entry.
+	got := Merge([]*List{base, {ChanceNone: 10, Entries: append(entries("dagger", "sword", "sword"), Entry{ID: "axe", Level: 5})}})
+	require.Equal(t, []Entry{{"dagger", 1}, {"sword", 1}, {"sword", 1}, {"axe", 5}}, got.Entries)
+	require.Nil(t, Merge(nil))
+}
@@ -0,0 +1,135 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package leveled
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// Player level an entry needs.
+const INTV esm.SubrecordTag = "INTV"
+
+// Player level an entry needs.
+type INTVField struct {
+	Level uint16
+}
+
+func (t *INTVField) Tag() esm.SubrecordTag { return INTV }
+
+// Size implements esm.Sized.
+func (t *INTVField) Size() int { return 2 }
+
+// Layout implements esm.Structured.
+func (t *INTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Level", Type: "u16", Offset: 0, Size: 2},
+	}
+}
+
+func (s *INTVField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 2 {
+		return fmt.Errorf("INTV must be 2 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Level = binary.LittleEndian.Uint16(d[0:2])
+	return nil
+}
+
+func (s *INTVField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 2)
+	binary.LittleEndian.PutUint16(d[0:2], uint16(s.Level))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Number of entries.
+const INDX esm.SubrecordTag = "INDX"
+
+// Number of entries.
+type INDXField struct{ Value uint32 }
+
+func (t *INDXField) Tag() esm.SubrecordTag { return INDX }
+
+// Size implements esm.Sized.
+func (t *INDXField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *INDXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *INDXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("INDX must be 4 bytes, got %d", len(sub.Data))
+	}
+	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
+	return nil
+}
+
+func (s *INDXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: buff.Bytes()}, nil
+}
+
+// Chance in percent that the list gives nothing.
+const NNAM esm.SubrecordTag = "NNAM"
+
+// Chance in percent that the list gives nothing.
+type NNAMField struct{ Value uint8 }
+
+func (t *NNAMField) Tag() esm.SubrecordTag { return NNAM }
+
+// Size implements esm.Sized.
+func (t *NNAMField) Size() int { return 1 }
+
+// Layout implements esm.Structured.
+func (t *NNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u8", Offset: 0, Size: 1},
+	}
+}
+
+func (s *NNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 1 {
+		return fmt.Errorf("NNAM must be 1 bytes, got %d", len(sub.Data))
+	}
+	s.Value = sub.Data[0]
+	return nil
+}
+
+func (s *NNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	buff := new(bytes.Buffer)
+
+	if err := binary.Write(buff, binary.LittleEndian, s.Value); err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: []byte{s.Value}}, nil
+}
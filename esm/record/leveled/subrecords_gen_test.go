@@ -0,0 +1,31 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package leveled
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("INDX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &INDXField{Value: r.Uint32()})
+		}
+	})
+	t.Run("INTV", func(t *testing.T) {
+		for range 32 {
+			s := &INTVField{}
+			s.Level = uint16(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("NNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NNAMField{Value: uint8(r.Uint32())})
+		}
+	})
+}
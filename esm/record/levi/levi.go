@@ -0,0 +1,61 @@
+// LEVI records contain leveled item lists.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package levi
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/leveled"
+)
+
+// LEVI handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/LEVI
+const LEVI esm.RecordTag = "LEVI"
+
+func init() {
+	esm.RegisterRecord(LEVI, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		l, err := ParseItemList(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	})
+	esm.RegisterSubrecords(LEVI, append([]esm.ParsedSubrecord{
+		&NAMEField{},
+		&DATAField{},
+		&INAMField{},
+	}, leveled.Subrecords()...)...)
+}
+
+// List implements leveled.Record.
+func (r *ItemListRecord) List() *leveled.List {
+	l := &leveled.List{Entries: []leveled.Entry{}}
+	if r.DATA != nil {
+		l.Flags = uint32(r.DATA.Value)
+	}
+	if r.NNAM != nil {
+		l.ChanceNone = r.NNAM.Value
+	}
+	for _, e := range r.Entries {
+		entry := leveled.Entry{ID: e.INAM.Value}
+		if e.INTV != nil {
+			entry.Level = e.INTV.Level
+		}
+		l.Entries = append(l.Entries, entry)
+	}
+	return l
+}
+
+// SetList implements leveled.Record.
+func (r *ItemListRecord) SetList(l *leveled.List) {
+	r.DATA = &DATAField{Value: Flags(l.Flags)}
+	r.NNAM = &leveled.NNAMField{Value: l.ChanceNone}
+	r.INDX = &leveled.INDXField{Value: uint32(len(l.Entries))}
+	r.Entries = []*Entry{}
+	for _, e := range l.Entries {
+		r.Entries = append(r.Entries, &Entry{
+			INAM: &INAMField{Value: e.ID},
+			INTV: &leveled.INTVField{Level: e.Level},
+		})
+	}
+}
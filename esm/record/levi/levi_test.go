@@ -0,0 +1,77 @@
+package levi
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/leveled"
+	"github.com/stretchr/testify/require"
+)
+
+func list(t *testing.T, name string, chance uint8, ids ...string) *esm.Record {
+	r := &ItemListRecord{NAME: &NAMEField{Value: name}}
+	l := &leveled.List{Flags: uint32(AllLevelsFlag), ChanceNone: chance}
+	for i, id := range ids {
+		l.Entries = append(l.Entries, leveled.Entry{ID: id, Level: uint16(i + 1)})
+	}
+	r.SetList(l)
+	rec, err := esm.Encode(r)
+	require.NoError(t, err)
+	return rec
+}
+
+func TestList(t *testing.T) {
+	r, err := ParseItemList(list(t, "random_weapon", 25, "dagger", "sword"))
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), r.INDX.Value)
+	require.Equal(t, &leveled.List{
+		Flags:      uint32(AllLevelsFlag),
+		ChanceNone: 25,
+		Entries:    []leveled.Entry{{ID: "dagger", Level: 1}, {ID: "sword", Level: 2}},
+	}, r.List())
+}
+
+func TestMergeLoadOrder(t *testing.T) {
+	deleted := list(t, "random_gone", 0, "a")
+	deleted.Subrecords = append(deleted.Subrecords, &esm.Subrecord{Tag: esm.DELE, Data: []byte{0, 0, 0, 0}})
+	reborn := list(t, "random_reborn", 0, "a", "b")
+	reborn.Subrecords = append(reborn.Subrecords, &esm.Subrecord{Tag: esm.DELE, Data: []byte{0, 0, 0, 0}})
+	plugins := []*esm.Plugin{
+		{Path: "Morrowind.esm", Records: []*esm.Record{
+			list(t, "random_weapon", 0, "dagger", "sword"),
+			list(t, "random_armor", 0, "cuirass"),
+			list(t, "random_gone", 0, "a"),
+			list(t, "random_same", 0, "a"),
+			list(t, "random_reborn", 0, "a", "b"),
+		}},
+		{Path: "weapons.esp", Records: []*esm.Record{
+			list(t, "random_weapon", 0, "dagger", "sword", "katana"),
+			list(t, "random_armor", 0, "cuirass", "helm"),
+			list(t, "random_only", 0, "a"),
+			reborn,
+		}},
+		{Path: "rebalance.esp", Records: []*esm.Record{
+			list(t, "RANDOM_WEAPON", 20, "sword", "club"),
+			deleted,
+			list(t, "random_same", 0, "a"),
+			list(t, "random_reborn", 0, "a"),
+		}},
+		{Path: "patch.esp", Records: []*esm.Record{
+			list(t, "random_reborn", 0, "a", "b", "c"),
+		}},
+	}
+	merged, err := leveled.MergeLoadOrder(plugins)
+	require.NoError(t, err)
+	// random_armor has one change, random_gone is deleted, random_only and
+	// random_same need no merging. random_reborn is re-created after its
+	// deletion, so only patch.esp changes it, and b isn't a removal.
+	require.Len(t, merged, 1)
+	r, err := ParseItemList(merged[0])
+	require.NoError(t, err)
+	require.Equal(t, "RANDOM_WEAPON", r.NAME.Value)
+	require.Equal(t, uint32(3), r.INDX.Value)
+	require.Equal(t, uint8(20), r.NNAM.Value)
+	// rebalance.esp moves sword to level 1 and drops dagger. The merged
+	// entries are sorted by level.
+	require.Equal(t, []leveled.Entry{{ID: "sword", Level: 1}, {ID: "club", Level: 2}, {ID: "katana", Level: 3}}, r.List().Entries)
+}
@@ -0,0 +1,29 @@
+// LIGH records contain lights.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package ligh
+
+import "github.com/ernmw/omwpacker/esm"
+
+// LIGH handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/LIGH
+const LIGH esm.RecordTag = "LIGH"
+
+func init() {
+	esm.RegisterRecord(LIGH, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		l, err := ParseLight(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	})
+	esm.RegisterSubrecords(LIGH,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&ITEXField{},
+		&LHDTField{},
+		&SCRIField{},
+		&SNAMField{},
+	)
+}
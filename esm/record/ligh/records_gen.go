@@ -0,0 +1,142 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package ligh
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// LightRecord is a light source, which may also be an item such as a torch.
+type LightRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	ITEX *ITEXField
+	LHDT *LHDTField
+	SCRI *SCRIField
+	SNAM *SNAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// lightRecordFields lists the tag that starts each field of LightRecord.
+var lightRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, ITEX, LHDT, SCRI, SNAM}
+
+func (r *LightRecord) Tag() esm.RecordTag { return LIGH }
+
+func (r *LightRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ITEX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.LHDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SNAM); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseLight builds a LightRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseLight(rec *esm.Record, opts ...esm.ParseOption) (*LightRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != LIGH {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseLightRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseLightRecord parses the LightRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseLightRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*LightRecord, int, error) {
+	r := &LightRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(lightRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.ITEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.ITEX, err = esm.ParseField[ITEXField](f, i)
+		case 4:
+			if r.LHDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.LHDT, err = esm.ParseField[LHDTField](f, i)
+		case 5:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 6:
+			if r.SNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.SNAM, err = esm.ParseField[SNAMField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,68 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package ligh
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Flags", func(t *testing.T) {
+		for range 32 {
+			want := Flags(r.Uint64())
+			got, err := ParseFlags(want.Names())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("ITEX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ITEXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("LHDT", func(t *testing.T) {
+		for range 32 {
+			s := &LHDTField{}
+			s.Weight = gentest.Float32(r)
+			s.Value = int32(r.Uint64())
+			s.Time = int32(r.Uint64())
+			s.Radius = uint32(r.Uint64())
+			s.R = uint8(r.Uint64())
+			s.G = uint8(r.Uint64())
+			s.B = uint8(r.Uint64())
+			s.A = uint8(r.Uint64())
+			s.Flags = Flags(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("MODL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MODLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SCRI", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCRIField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+}
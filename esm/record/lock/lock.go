@@ -0,0 +1,28 @@
+// LOCK records contain lockpicks.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package lock
+
+import "github.com/ernmw/omwpacker/esm"
+
+// LOCK handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/LOCK
+const LOCK esm.RecordTag = "LOCK"
+
+func init() {
+	esm.RegisterRecord(LOCK, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		l, err := ParseLockpick(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	})
+	esm.RegisterSubrecords(LOCK,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&LKDTField{},
+		&SCRIField{},
+		&ITEXField{},
+	)
+}
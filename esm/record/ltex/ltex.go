@@ -3,9 +3,29 @@
 //go:generate go run ../generator/gen.go subrecords.json
 package ltex
 
-import "github.com/ernmw/omwpacker/esm"
+import (
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+)
 
 const (
 	// LTEX records contain information about landscape textures.
 	LTEX esm.RecordTag = "LTEX"
 )
+
+func init() {
+	// LAND VTEX subrecords refer to textures by index, not by name.
+	esm.RegisterIdentity(LTEX, func(rec *esm.Record) (string, error) {
+		intv := &INTVField{}
+		if err := rec.UnmarshalFirst(intv); err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(uint64(intv.Value), 10), nil
+	})
+	esm.RegisterSubrecords(LTEX,
+		&NAMEField{},
+		&INTVField{},
+		&DATAField{},
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
 )
 
 // Texture filename.
@@ -17,6 +18,13 @@ type DATAField struct{ Value string }
 
 func (t *DATAField) Tag() esm.SubrecordTag { return DATA }
 
+// Layout implements esm.Structured.
+func (t *DATAField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
 func (s *DATAField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
@@ -28,7 +36,7 @@ func (s *DATAField) Unmarshal(sub *esm.Subrecord) error {
 	if sub.Data[len(sub.Data)-1] != 0 {
 		return fmt.Errorf("zstring subrecord not null-terminated")
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
 
 	return nil
 }
@@ -38,7 +46,11 @@ func (s *DATAField) Marshal() (*esm.Subrecord, error) {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode DATA: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
 
 // Index.
@@ -49,10 +61,23 @@ type INTVField struct{ Value uint32 }
 
 func (t *INTVField) Tag() esm.SubrecordTag { return INTV }
 
+// Size implements esm.Sized.
+func (t *INTVField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *INTVField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
 func (s *INTVField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("INTV must be 4 bytes, got %d", len(sub.Data))
+	}
 	s.Value = binary.LittleEndian.Uint32(sub.Data[0:4])
 	return nil
 }
@@ -78,6 +103,13 @@ type NAMEField struct{ Value string }
 
 func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
 
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
 func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
@@ -89,7 +121,7 @@ func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
 	if sub.Data[len(sub.Data)-1] != 0 {
 		return fmt.Errorf("zstring subrecord not null-terminated")
 	}
-	s.Value = string(sub.Data[:len(sub.Data)-1])
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
 
 	return nil
 }
@@ -99,5 +131,9 @@ func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: append([]byte(s.Value), 0)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
 }
@@ -31,3 +31,11 @@ const (
 	// LUAI - Attach script to a specific instance (LuaScriptCfg::PerRefCfg)
 	LUAI esm.SubrecordTag = "LUAI"
 )
+
+func init() {
+	esm.RegisterIdentity(LUAL, esm.Singleton)
+	esm.RegisterSubrecords(LUAL,
+		&LUASField{},
+		&LUAFField{},
+	)
+}
@@ -9,7 +9,7 @@ import (
 
 func TestLUAF(t *testing.T) {
 	h := &LUAFField{
-		Flags:   0,
+		Flags:   GlobalFlag | MenuFlag,
 		Targets: []string{"CREA", "DOOR"},
 	}
 	raw, err := h.Marshal()
@@ -20,6 +20,7 @@ func TestLUAF(t *testing.T) {
 	require.NoError(t, raw.UnmarshalTo(h2))
 	require.Equal(t, "CREA", h2.Targets[0])
 	require.Equal(t, "DOOR", h2.Targets[1])
+	require.Equal(t, "Global|Menu", h2.Flags.String())
 
 	t.Run("short tag", func(t *testing.T) {
 		h := &LUAFField{
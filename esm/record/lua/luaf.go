@@ -3,6 +3,7 @@ package lua
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"strings"
 
 	"github.com/ernmw/omwpacker/esm"
@@ -31,17 +32,7 @@ import (
 type LUAFField struct {
 	// Flags cover types of script attachment which don't logically correlate to a type of gameobject -> Player, Global, Custom, Menu, etc.
 	// Player is one of them, since they are an NPC.
-	//
-	// sGlobal = 1ull << 0; // start as a global script
-	//
-	// sCustom = 1ull << 1; // local; can be attached/detached by a global script
-	//
-	// sPlayer = 1ull << 2; // auto attach to players
-	//
-	// sMerge = 1ull << 3; // merge with configuration from previous content files
-	//
-	// sMenu = 1ull << 4; // start as a menu script
-	Flags uint32
+	Flags ScriptFlags
 	// Targets is a list of 4-byte strings which map to ccfour constants.
 	Targets []string
 }
@@ -50,16 +41,29 @@ func (h *LUAFField) Tag() esm.SubrecordTag {
 	return LUAF
 }
 
+// Layout implements esm.Structured.
+func (h *LUAFField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Flags", Type: "u32", Offset: 0, Size: 4},
+		{Name: "Targets", Type: "x", Offset: 4, Size: -1},
+	}
+}
+
 func (h *LUAFField) Unmarshal(sub *esm.Subrecord) error {
 	if h == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
-	h.Flags = binary.LittleEndian.Uint32(sub.Data[0:4])
+	// Same check as ESM::LuaScriptsCfg::load.
+	if len(sub.Data) < 4 || len(sub.Data)%4 != 0 {
+		return fmt.Errorf("LUAF size %d is not a positive multiple of 4", len(sub.Data))
+	}
+	h.Flags = ScriptFlags(binary.LittleEndian.Uint32(sub.Data[0:4]))
 
-	rawTargets := util.ReadPaddedString(sub.Data[4:])
+	// Targets are ESM::RecNameInts, not strings, so they're not decoded.
+	rawTargets := sub.Data[4:]
 	h.Targets = make([]string, len(rawTargets)/4)
 	for i := 0; i < len(rawTargets); i = i + 4 {
-		h.Targets[i/4] = rawTargets[i : i+4]
+		h.Targets[i/4] = string(rawTargets[i : i+4])
 	}
 
 	return nil
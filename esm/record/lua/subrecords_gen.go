@@ -2,7 +2,13 @@
 package lua
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
 )
 
 // VFS path to a Lua script.
@@ -13,12 +19,19 @@ type LUASField struct{ Value string }
 
 func (t *LUASField) Tag() esm.SubrecordTag { return LUAS }
 
+// Layout implements esm.Structured.
+func (t *LUASField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
 func (s *LUASField) Unmarshal(sub *esm.Subrecord) error {
 	if s == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
 
-	s.Value = string(sub.Data)
+	s.Value = util.DecodeString(sub.Data)
 
 	return nil
 }
@@ -28,5 +41,141 @@ func (s *LUASField) Marshal() (*esm.Subrecord, error) {
 		return nil, nil
 	}
 
-	return &esm.Subrecord{Tag: s.Tag(), Data: []byte(s.Value)}, nil
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode LUAS: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: raw}, nil
+}
+
+// ScriptFlags are LuaScriptCfg::mFlags, stored at the start of LUAF.
+type ScriptFlags uint32
+
+const (
+	// Start as a global script.
+	GlobalFlag ScriptFlags = 0x01
+	// Local; can be attached/detached by a global script.
+	CustomFlag ScriptFlags = 0x02
+	// Auto attach to players.
+	PlayerFlag ScriptFlags = 0x04
+	// Merge with configuration from previous content files.
+	MergeFlag ScriptFlags = 0x08
+	// Start as a menu script.
+	MenuFlag ScriptFlags = 0x10
+)
+
+// scriptFlagsNames lists the named bits of ScriptFlags, in order.
+var scriptFlagsNames = []struct {
+	flag ScriptFlags
+	name string
+}{
+	{GlobalFlag, "Global"},
+	{CustomFlag, "Custom"},
+	{PlayerFlag, "Player"},
+	{MergeFlag, "Merge"},
+	{MenuFlag, "Menu"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f ScriptFlags) Has(flag ScriptFlags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *ScriptFlags) Set(flag ScriptFlags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f ScriptFlags) Names() []string {
+	names := []string{}
+	for _, n := range scriptFlagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f ScriptFlags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseScriptFlags combines bit names, as returned by Names, into a ScriptFlags.
+// Numbers are accepted too.
+func ParseScriptFlags(names []string) (ScriptFlags, error) {
+	var f ScriptFlags
+next:
+	for _, name := range names {
+		for _, n := range scriptFlagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown ScriptFlags %q", name)
+		}
+		f |= ScriptFlags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f ScriptFlags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *ScriptFlags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = ScriptFlags(v)
+		return nil
+	}
+	v, err := ParseScriptFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f ScriptFlags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *ScriptFlags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = ScriptFlags(v)
+		return nil
+	}
+	v, err := ParseScriptFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
 }
@@ -0,0 +1,28 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package lua
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("LUAS", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &LUASField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("ScriptFlags", func(t *testing.T) {
+		for range 32 {
+			want := ScriptFlags(r.Uint64())
+			got, err := ParseScriptFlags(want.Names())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+// Package magic contains the effect list that spells, enchantments and
+// potions share, and the costs derived from it. It registers nothing
+// itself; the record packages do.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+package magic
+
+import (
+	"fmt"
+	"math"
+)
+
+// Cost returns the magicka cost of e, given the base cost of its magic
+// effect and the fEffectCostMult game setting (0.5 by default). As in the
+// game, magnitudes and duration count as at least 1 and targeted effects
+// cost half again as much. The game also ignores the magnitude and
+// duration of effects that have none, which the file doesn't say.
+func (e *ENAMField) Cost(baseCost, mult float32) float32 {
+	magnitude := float32(max(1, e.MagnitudeMin)+max(1, e.MagnitudeMax)) / 2
+	cost := magnitude * baseCost / 10 * float32(max(1, e.Duration))
+	cost += float32(max(0, e.Area)) * baseCost / 20
+	cost *= mult
+	if e.Range == TargetRange {
+		cost *= 1.5
+	}
+	return cost
+}
+
+// TotalCost returns the rounded sum of the costs of effects, which is
+// what autocalculated spells cost. baseCost looks up the base cost of a
+// magic effect.
+func TotalCost(effects []*ENAMField, baseCost func(Effect) (float32, bool), mult float32) (int32, error) {
+	total := float32(0)
+	for _, e := range effects {
+		base, ok := baseCost(e.Effect)
+		if !ok {
+			return 0, fmt.Errorf("no base cost for %s", e.Effect)
+		}
+		total += e.Cost(base, mult)
+	}
+	return int32(math.Round(float64(total))), nil
+}
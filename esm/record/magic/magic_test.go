@@ -0,0 +1,38 @@
+package magic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCost(t *testing.T) {
+	fireball := &ENAMField{Effect: FireDamageEffect, Range: TargetRange, Area: 10, Duration: 1, MagnitudeMin: 5, MagnitudeMax: 20}
+	require.InDelta(t, 6.5625, fireball.Cost(5, 0.5), 1e-6)
+
+	// Zero magnitudes and duration count as 1.
+	light := &ENAMField{Effect: LightEffect}
+	require.InDelta(t, 0.05, light.Cost(1, 0.5), 1e-6)
+
+	baseCosts := map[Effect]float32{FireDamageEffect: 5, LightEffect: 1}
+	lookup := func(e Effect) (float32, bool) {
+		cost, ok := baseCosts[e]
+		return cost, ok
+	}
+	total, err := TotalCost([]*ENAMField{fireball, light}, lookup, 0.5)
+	require.NoError(t, err)
+	require.Equal(t, int32(7), total)
+
+	_, err = TotalCost([]*ENAMField{{Effect: SummonCreature05Effect}}, lookup, 0.5)
+	require.ErrorContains(t, err, "SummonCreature05")
+}
+
+func TestEffectNames(t *testing.T) {
+	require.Equal(t, Effect(142), SummonCreature05Effect)
+	require.Equal(t, "RestoreHealth", RestoreHealthEffect.String())
+	require.Equal(t, "200", Effect(200).String())
+
+	var e Effect
+	require.NoError(t, e.UnmarshalText([]byte("Levitate")))
+	require.Equal(t, LevitateEffect, e)
+}
@@ -0,0 +1,1017 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package magic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// A magic effect of a spell, enchantment or potion.
+const ENAM esm.SubrecordTag = "ENAM"
+
+// A magic effect of a spell, enchantment or potion.
+type ENAMField struct {
+	Effect Effect
+	// Affected skill, or -1.
+	Skill int8
+	// Affected attribute, or -1.
+	Attribute int8
+	Range     Range
+	// Radius in feet.
+	Area int32
+	// Seconds.
+	Duration     int32
+	MagnitudeMin int32
+	MagnitudeMax int32
+}
+
+func (t *ENAMField) Tag() esm.SubrecordTag { return ENAM }
+
+// Size implements esm.Sized.
+func (t *ENAMField) Size() int { return 24 }
+
+// Layout implements esm.Structured.
+func (t *ENAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Effect", Type: "u16", Offset: 0, Size: 2},
+		{Name: "Skill", Type: "i8", Offset: 2, Size: 1},
+		{Name: "Attribute", Type: "i8", Offset: 3, Size: 1},
+		{Name: "Range", Type: "u32", Offset: 4, Size: 4},
+		{Name: "Area", Type: "i32", Offset: 8, Size: 4},
+		{Name: "Duration", Type: "i32", Offset: 12, Size: 4},
+		{Name: "MagnitudeMin", Type: "i32", Offset: 16, Size: 4},
+		{Name: "MagnitudeMax", Type: "i32", Offset: 20, Size: 4},
+	}
+}
+
+func (s *ENAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 24 {
+		return fmt.Errorf("ENAM must be 24 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Effect = Effect(binary.LittleEndian.Uint16(d[0:2]))
+	s.Skill = int8(d[2])
+	s.Attribute = int8(d[3])
+	s.Range = Range(binary.LittleEndian.Uint32(d[4:8]))
+	s.Area = int32(binary.LittleEndian.Uint32(d[8:12]))
+	s.Duration = int32(binary.LittleEndian.Uint32(d[12:16]))
+	s.MagnitudeMin = int32(binary.LittleEndian.Uint32(d[16:20]))
+	s.MagnitudeMax = int32(binary.LittleEndian.Uint32(d[20:24]))
+	return nil
+}
+
+func (s *ENAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 24)
+	binary.LittleEndian.PutUint16(d[0:2], uint16(s.Effect))
+	d[2] = byte(s.Skill)
+	d[3] = byte(s.Attribute)
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.Range))
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.Area))
+	binary.LittleEndian.PutUint32(d[12:16], uint32(s.Duration))
+	binary.LittleEndian.PutUint32(d[16:20], uint32(s.MagnitudeMin))
+	binary.LittleEndian.PutUint32(d[20:24], uint32(s.MagnitudeMax))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Effect is the index of a magic effect. The vanilla ones are named.
+type Effect uint16
+
+const (
+	WaterBreathingEffect           Effect = 0
+	SwiftSwimEffect                Effect = 1
+	WaterWalkingEffect             Effect = 2
+	ShieldEffect                   Effect = 3
+	FireShieldEffect               Effect = 4
+	LightningShieldEffect          Effect = 5
+	FrostShieldEffect              Effect = 6
+	BurdenEffect                   Effect = 7
+	FeatherEffect                  Effect = 8
+	JumpEffect                     Effect = 9
+	LevitateEffect                 Effect = 10
+	SlowFallEffect                 Effect = 11
+	LockEffect                     Effect = 12
+	OpenEffect                     Effect = 13
+	FireDamageEffect               Effect = 14
+	ShockDamageEffect              Effect = 15
+	FrostDamageEffect              Effect = 16
+	DrainAttributeEffect           Effect = 17
+	DrainHealthEffect              Effect = 18
+	DrainMagickaEffect             Effect = 19
+	DrainFatigueEffect             Effect = 20
+	DrainSkillEffect               Effect = 21
+	DamageAttributeEffect          Effect = 22
+	DamageHealthEffect             Effect = 23
+	DamageMagickaEffect            Effect = 24
+	DamageFatigueEffect            Effect = 25
+	DamageSkillEffect              Effect = 26
+	PoisonEffect                   Effect = 27
+	WeaknessToFireEffect           Effect = 28
+	WeaknessToFrostEffect          Effect = 29
+	WeaknessToShockEffect          Effect = 30
+	WeaknessToMagickaEffect        Effect = 31
+	WeaknessToCommonDiseaseEffect  Effect = 32
+	WeaknessToBlightDiseaseEffect  Effect = 33
+	WeaknessToCorprusDiseaseEffect Effect = 34
+	WeaknessToPoisonEffect         Effect = 35
+	WeaknessToNormalWeaponsEffect  Effect = 36
+	DisintegrateWeaponEffect       Effect = 37
+	DisintegrateArmorEffect        Effect = 38
+	InvisibilityEffect             Effect = 39
+	ChameleonEffect                Effect = 40
+	LightEffect                    Effect = 41
+	SanctuaryEffect                Effect = 42
+	NightEyeEffect                 Effect = 43
+	CharmEffect                    Effect = 44
+	ParalyzeEffect                 Effect = 45
+	SilenceEffect                  Effect = 46
+	BlindEffect                    Effect = 47
+	SoundEffect                    Effect = 48
+	CalmHumanoidEffect             Effect = 49
+	CalmCreatureEffect             Effect = 50
+	FrenzyHumanoidEffect           Effect = 51
+	FrenzyCreatureEffect           Effect = 52
+	DemoralizeHumanoidEffect       Effect = 53
+	DemoralizeCreatureEffect       Effect = 54
+	RallyHumanoidEffect            Effect = 55
+	RallyCreatureEffect            Effect = 56
+	DispelEffect                   Effect = 57
+	SoultrapEffect                 Effect = 58
+	TelekinesisEffect              Effect = 59
+	MarkEffect                     Effect = 60
+	RecallEffect                   Effect = 61
+	DivineInterventionEffect       Effect = 62
+	AlmsiviInterventionEffect      Effect = 63
+	DetectAnimalEffect             Effect = 64
+	DetectEnchantmentEffect        Effect = 65
+	DetectKeyEffect                Effect = 66
+	SpellAbsorptionEffect          Effect = 67
+	ReflectEffect                  Effect = 68
+	CureCommonDiseaseEffect        Effect = 69
+	CureBlightDiseaseEffect        Effect = 70
+	CureCorprusDiseaseEffect       Effect = 71
+	CurePoisonEffect               Effect = 72
+	CureParalyzationEffect         Effect = 73
+	RestoreAttributeEffect         Effect = 74
+	RestoreHealthEffect            Effect = 75
+	RestoreMagickaEffect           Effect = 76
+	RestoreFatigueEffect           Effect = 77
+	RestoreSkillEffect             Effect = 78
+	FortifyAttributeEffect         Effect = 79
+	FortifyHealthEffect            Effect = 80
+	FortifyMagickaEffect           Effect = 81
+	FortifyFatigueEffect           Effect = 82
+	FortifySkillEffect             Effect = 83
+	FortifyMaximumMagickaEffect    Effect = 84
+	AbsorbAttributeEffect          Effect = 85
+	AbsorbHealthEffect             Effect = 86
+	AbsorbMagickaEffect            Effect = 87
+	AbsorbFatigueEffect            Effect = 88
+	AbsorbSkillEffect              Effect = 89
+	ResistFireEffect               Effect = 90
+	ResistFrostEffect              Effect = 91
+	ResistShockEffect              Effect = 92
+	ResistMagickaEffect            Effect = 93
+	ResistCommonDiseaseEffect      Effect = 94
+	ResistBlightDiseaseEffect      Effect = 95
+	ResistCorprusDiseaseEffect     Effect = 96
+	ResistPoisonEffect             Effect = 97
+	ResistNormalWeaponsEffect      Effect = 98
+	ResistParalysisEffect          Effect = 99
+	RemoveCurseEffect              Effect = 100
+	TurnUndeadEffect               Effect = 101
+	SummonScampEffect              Effect = 102
+	SummonClannfearEffect          Effect = 103
+	SummonDaedrothEffect           Effect = 104
+	SummonDremoraEffect            Effect = 105
+	SummonAncestralGhostEffect     Effect = 106
+	SummonSkeletalMinionEffect     Effect = 107
+	SummonBonewalkerEffect         Effect = 108
+	SummonGreaterBonewalkerEffect  Effect = 109
+	SummonBonelordEffect           Effect = 110
+	SummonWingedTwilightEffect     Effect = 111
+	SummonHungerEffect             Effect = 112
+	SummonGoldenSaintEffect        Effect = 113
+	SummonFlameAtronachEffect      Effect = 114
+	SummonFrostAtronachEffect      Effect = 115
+	SummonStormAtronachEffect      Effect = 116
+	FortifyAttackEffect            Effect = 117
+	CommandCreatureEffect          Effect = 118
+	CommandHumanoidEffect          Effect = 119
+	BoundDaggerEffect              Effect = 120
+	BoundLongswordEffect           Effect = 121
+	BoundMaceEffect                Effect = 122
+	BoundBattleAxeEffect           Effect = 123
+	BoundSpearEffect               Effect = 124
+	BoundLongbowEffect             Effect = 125
+	ExtraSpellEffect               Effect = 126
+	BoundCuirassEffect             Effect = 127
+	BoundHelmEffect                Effect = 128
+	BoundBootsEffect               Effect = 129
+	BoundShieldEffect              Effect = 130
+	BoundGlovesEffect              Effect = 131
+	CorprusEffect                  Effect = 132
+	VampirismEffect                Effect = 133
+	SummonCenturionSphereEffect    Effect = 134
+	SunDamageEffect                Effect = 135
+	StuntedMagickaEffect           Effect = 136
+	SummonFabricantEffect          Effect = 137
+	SummonWolfEffect               Effect = 138
+	SummonBearEffect               Effect = 139
+	SummonBonewolfEffect           Effect = 140
+	SummonCreature04Effect         Effect = 141
+	SummonCreature05Effect         Effect = 142
+)
+
+func (e Effect) String() string {
+	switch e {
+	case WaterBreathingEffect:
+		return "WaterBreathing"
+	case SwiftSwimEffect:
+		return "SwiftSwim"
+	case WaterWalkingEffect:
+		return "WaterWalking"
+	case ShieldEffect:
+		return "Shield"
+	case FireShieldEffect:
+		return "FireShield"
+	case LightningShieldEffect:
+		return "LightningShield"
+	case FrostShieldEffect:
+		return "FrostShield"
+	case BurdenEffect:
+		return "Burden"
+	case FeatherEffect:
+		return "Feather"
+	case JumpEffect:
+		return "Jump"
+	case LevitateEffect:
+		return "Levitate"
+	case SlowFallEffect:
+		return "SlowFall"
+	case LockEffect:
+		return "Lock"
+	case OpenEffect:
+		return "Open"
+	case FireDamageEffect:
+		return "FireDamage"
+	case ShockDamageEffect:
+		return "ShockDamage"
+	case FrostDamageEffect:
+		return "FrostDamage"
+	case DrainAttributeEffect:
+		return "DrainAttribute"
+	case DrainHealthEffect:
+		return "DrainHealth"
+	case DrainMagickaEffect:
+		return "DrainMagicka"
+	case DrainFatigueEffect:
+		return "DrainFatigue"
+	case DrainSkillEffect:
+		return "DrainSkill"
+	case DamageAttributeEffect:
+		return "DamageAttribute"
+	case DamageHealthEffect:
+		return "DamageHealth"
+	case DamageMagickaEffect:
+		return "DamageMagicka"
+	case DamageFatigueEffect:
+		return "DamageFatigue"
+	case DamageSkillEffect:
+		return "DamageSkill"
+	case PoisonEffect:
+		return "Poison"
+	case WeaknessToFireEffect:
+		return "WeaknessToFire"
+	case WeaknessToFrostEffect:
+		return "WeaknessToFrost"
+	case WeaknessToShockEffect:
+		return "WeaknessToShock"
+	case WeaknessToMagickaEffect:
+		return "WeaknessToMagicka"
+	case WeaknessToCommonDiseaseEffect:
+		return "WeaknessToCommonDisease"
+	case WeaknessToBlightDiseaseEffect:
+		return "WeaknessToBlightDisease"
+	case WeaknessToCorprusDiseaseEffect:
+		return "WeaknessToCorprusDisease"
+	case WeaknessToPoisonEffect:
+		return "WeaknessToPoison"
+	case WeaknessToNormalWeaponsEffect:
+		return "WeaknessToNormalWeapons"
+	case DisintegrateWeaponEffect:
+		return "DisintegrateWeapon"
+	case DisintegrateArmorEffect:
+		return "DisintegrateArmor"
+	case InvisibilityEffect:
+		return "Invisibility"
+	case ChameleonEffect:
+		return "Chameleon"
+	case LightEffect:
+		return "Light"
+	case SanctuaryEffect:
+		return "Sanctuary"
+	case NightEyeEffect:
+		return "NightEye"
+	case CharmEffect:
+		return "Charm"
+	case ParalyzeEffect:
+		return "Paralyze"
+	case SilenceEffect:
+		return "Silence"
+	case BlindEffect:
+		return "Blind"
+	case SoundEffect:
+		return "Sound"
+	case CalmHumanoidEffect:
+		return "CalmHumanoid"
+	case CalmCreatureEffect:
+		return "CalmCreature"
+	case FrenzyHumanoidEffect:
+		return "FrenzyHumanoid"
+	case FrenzyCreatureEffect:
+		return "FrenzyCreature"
+	case DemoralizeHumanoidEffect:
+		return "DemoralizeHumanoid"
+	case DemoralizeCreatureEffect:
+		return "DemoralizeCreature"
+	case RallyHumanoidEffect:
+		return "RallyHumanoid"
+	case RallyCreatureEffect:
+		return "RallyCreature"
+	case DispelEffect:
+		return "Dispel"
+	case SoultrapEffect:
+		return "Soultrap"
+	case TelekinesisEffect:
+		return "Telekinesis"
+	case MarkEffect:
+		return "Mark"
+	case RecallEffect:
+		return "Recall"
+	case DivineInterventionEffect:
+		return "DivineIntervention"
+	case AlmsiviInterventionEffect:
+		return "AlmsiviIntervention"
+	case DetectAnimalEffect:
+		return "DetectAnimal"
+	case DetectEnchantmentEffect:
+		return "DetectEnchantment"
+	case DetectKeyEffect:
+		return "DetectKey"
+	case SpellAbsorptionEffect:
+		return "SpellAbsorption"
+	case ReflectEffect:
+		return "Reflect"
+	case CureCommonDiseaseEffect:
+		return "CureCommonDisease"
+	case CureBlightDiseaseEffect:
+		return "CureBlightDisease"
+	case CureCorprusDiseaseEffect:
+		return "CureCorprusDisease"
+	case CurePoisonEffect:
+		return "CurePoison"
+	case CureParalyzationEffect:
+		return "CureParalyzation"
+	case RestoreAttributeEffect:
+		return "RestoreAttribute"
+	case RestoreHealthEffect:
+		return "RestoreHealth"
+	case RestoreMagickaEffect:
+		return "RestoreMagicka"
+	case RestoreFatigueEffect:
+		return "RestoreFatigue"
+	case RestoreSkillEffect:
+		return "RestoreSkill"
+	case FortifyAttributeEffect:
+		return "FortifyAttribute"
+	case FortifyHealthEffect:
+		return "FortifyHealth"
+	case FortifyMagickaEffect:
+		return "FortifyMagicka"
+	case FortifyFatigueEffect:
+		return "FortifyFatigue"
+	case FortifySkillEffect:
+		return "FortifySkill"
+	case FortifyMaximumMagickaEffect:
+		return "FortifyMaximumMagicka"
+	case AbsorbAttributeEffect:
+		return "AbsorbAttribute"
+	case AbsorbHealthEffect:
+		return "AbsorbHealth"
+	case AbsorbMagickaEffect:
+		return "AbsorbMagicka"
+	case AbsorbFatigueEffect:
+		return "AbsorbFatigue"
+	case AbsorbSkillEffect:
+		return "AbsorbSkill"
+	case ResistFireEffect:
+		return "ResistFire"
+	case ResistFrostEffect:
+		return "ResistFrost"
+	case ResistShockEffect:
+		return "ResistShock"
+	case ResistMagickaEffect:
+		return "ResistMagicka"
+	case ResistCommonDiseaseEffect:
+		return "ResistCommonDisease"
+	case ResistBlightDiseaseEffect:
+		return "ResistBlightDisease"
+	case ResistCorprusDiseaseEffect:
+		return "ResistCorprusDisease"
+	case ResistPoisonEffect:
+		return "ResistPoison"
+	case ResistNormalWeaponsEffect:
+		return "ResistNormalWeapons"
+	case ResistParalysisEffect:
+		return "ResistParalysis"
+	case RemoveCurseEffect:
+		return "RemoveCurse"
+	case TurnUndeadEffect:
+		return "TurnUndead"
+	case SummonScampEffect:
+		return "SummonScamp"
+	case SummonClannfearEffect:
+		return "SummonClannfear"
+	case SummonDaedrothEffect:
+		return "SummonDaedroth"
+	case SummonDremoraEffect:
+		return "SummonDremora"
+	case SummonAncestralGhostEffect:
+		return "SummonAncestralGhost"
+	case SummonSkeletalMinionEffect:
+		return "SummonSkeletalMinion"
+	case SummonBonewalkerEffect:
+		return "SummonBonewalker"
+	case SummonGreaterBonewalkerEffect:
+		return "SummonGreaterBonewalker"
+	case SummonBonelordEffect:
+		return "SummonBonelord"
+	case SummonWingedTwilightEffect:
+		return "SummonWingedTwilight"
+	case SummonHungerEffect:
+		return "SummonHunger"
+	case SummonGoldenSaintEffect:
+		return "SummonGoldenSaint"
+	case SummonFlameAtronachEffect:
+		return "SummonFlameAtronach"
+	case SummonFrostAtronachEffect:
+		return "SummonFrostAtronach"
+	case SummonStormAtronachEffect:
+		return "SummonStormAtronach"
+	case FortifyAttackEffect:
+		return "FortifyAttack"
+	case CommandCreatureEffect:
+		return "CommandCreature"
+	case CommandHumanoidEffect:
+		return "CommandHumanoid"
+	case BoundDaggerEffect:
+		return "BoundDagger"
+	case BoundLongswordEffect:
+		return "BoundLongsword"
+	case BoundMaceEffect:
+		return "BoundMace"
+	case BoundBattleAxeEffect:
+		return "BoundBattleAxe"
+	case BoundSpearEffect:
+		return "BoundSpear"
+	case BoundLongbowEffect:
+		return "BoundLongbow"
+	case ExtraSpellEffect:
+		return "ExtraSpell"
+	case BoundCuirassEffect:
+		return "BoundCuirass"
+	case BoundHelmEffect:
+		return "BoundHelm"
+	case BoundBootsEffect:
+		return "BoundBoots"
+	case BoundShieldEffect:
+		return "BoundShield"
+	case BoundGlovesEffect:
+		return "BoundGloves"
+	case CorprusEffect:
+		return "Corprus"
+	case VampirismEffect:
+		return "Vampirism"
+	case SummonCenturionSphereEffect:
+		return "SummonCenturionSphere"
+	case SunDamageEffect:
+		return "SunDamage"
+	case StuntedMagickaEffect:
+		return "StuntedMagicka"
+	case SummonFabricantEffect:
+		return "SummonFabricant"
+	case SummonWolfEffect:
+		return "SummonWolf"
+	case SummonBearEffect:
+		return "SummonBear"
+	case SummonBonewolfEffect:
+		return "SummonBonewolf"
+	case SummonCreature04Effect:
+		return "SummonCreature04"
+	case SummonCreature05Effect:
+		return "SummonCreature05"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Effect) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Effect) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "WaterBreathing":
+		*e = WaterBreathingEffect
+		return nil
+	case "SwiftSwim":
+		*e = SwiftSwimEffect
+		return nil
+	case "WaterWalking":
+		*e = WaterWalkingEffect
+		return nil
+	case "Shield":
+		*e = ShieldEffect
+		return nil
+	case "FireShield":
+		*e = FireShieldEffect
+		return nil
+	case "LightningShield":
+		*e = LightningShieldEffect
+		return nil
+	case "FrostShield":
+		*e = FrostShieldEffect
+		return nil
+	case "Burden":
+		*e = BurdenEffect
+		return nil
+	case "Feather":
+		*e = FeatherEffect
+		return nil
+	case "Jump":
+		*e = JumpEffect
+		return nil
+	case "Levitate":
+		*e = LevitateEffect
+		return nil
+	case "SlowFall":
+		*e = SlowFallEffect
+		return nil
+	case "Lock":
+		*e = LockEffect
+		return nil
+	case "Open":
+		*e = OpenEffect
+		return nil
+	case "FireDamage":
+		*e = FireDamageEffect
+		return nil
+	case "ShockDamage":
+		*e = ShockDamageEffect
+		return nil
+	case "FrostDamage":
+		*e = FrostDamageEffect
+		return nil
+	case "DrainAttribute":
+		*e = DrainAttributeEffect
+		return nil
+	case "DrainHealth":
+		*e = DrainHealthEffect
+		return nil
+	case "DrainMagicka":
+		*e = DrainMagickaEffect
+		return nil
+	case "DrainFatigue":
+		*e = DrainFatigueEffect
+		return nil
+	case "DrainSkill":
+		*e = DrainSkillEffect
+		return nil
+	case "DamageAttribute":
+		*e = DamageAttributeEffect
+		return nil
+	case "DamageHealth":
+		*e = DamageHealthEffect
+		return nil
+	case "DamageMagicka":
+		*e = DamageMagickaEffect
+		return nil
+	case "DamageFatigue":
+		*e = DamageFatigueEffect
+		return nil
+	case "DamageSkill":
+		*e = DamageSkillEffect
+		return nil
+	case "Poison":
+		*e = PoisonEffect
+		return nil
+	case "WeaknessToFire":
+		*e = WeaknessToFireEffect
+		return nil
+	case "WeaknessToFrost":
+		*e = WeaknessToFrostEffect
+		return nil
+	case "WeaknessToShock":
+		*e = WeaknessToShockEffect
+		return nil
+	case "WeaknessToMagicka":
+		*e = WeaknessToMagickaEffect
+		return nil
+	case "WeaknessToCommonDisease":
+		*e = WeaknessToCommonDiseaseEffect
+		return nil
+	case "WeaknessToBlightDisease":
+		*e = WeaknessToBlightDiseaseEffect
+		return nil
+	case "WeaknessToCorprusDisease":
+		*e = WeaknessToCorprusDiseaseEffect
+		return nil
+	case "WeaknessToPoison":
+		*e = WeaknessToPoisonEffect
+		return nil
+	case "WeaknessToNormalWeapons":
+		*e = WeaknessToNormalWeaponsEffect
+		return nil
+	case "DisintegrateWeapon":
+		*e = DisintegrateWeaponEffect
+		return nil
+	case "DisintegrateArmor":
+		*e = DisintegrateArmorEffect
+		return nil
+	case "Invisibility":
+		*e = InvisibilityEffect
+		return nil
+	case "Chameleon":
+		*e = ChameleonEffect
+		return nil
+	case "Light":
+		*e = LightEffect
+		return nil
+	case "Sanctuary":
+		*e = SanctuaryEffect
+		return nil
+	case "NightEye":
+		*e = NightEyeEffect
+		return nil
+	case "Charm":
+		*e = CharmEffect
+		return nil
+	case "Paralyze":
+		*e = ParalyzeEffect
+		return nil
+	case "Silence":
+		*e = SilenceEffect
+		return nil
+	case "Blind":
+		*e = BlindEffect
+		return nil
+	case "Sound":
+		*e = SoundEffect
+		return nil
+	case "CalmHumanoid":
+		*e = CalmHumanoidEffect
+		return nil
+	case "CalmCreature":
+		*e = CalmCreatureEffect
+		return nil
+	case "FrenzyHumanoid":
+		*e = FrenzyHumanoidEffect
+		return nil
+	case "FrenzyCreature":
+		*e = FrenzyCreatureEffect
+		return nil
+	case "DemoralizeHumanoid":
+		*e = DemoralizeHumanoidEffect
+		return nil
+	case "DemoralizeCreature":
+		*e = DemoralizeCreatureEffect
+		return nil
+	case "RallyHumanoid":
+		*e = RallyHumanoidEffect
+		return nil
+	case "RallyCreature":
+		*e = RallyCreatureEffect
+		return nil
+	case "Dispel":
+		*e = DispelEffect
+		return nil
+	case "Soultrap":
+		*e = SoultrapEffect
+		return nil
+	case "Telekinesis":
+		*e = TelekinesisEffect
+		return nil
+	case "Mark":
+		*e = MarkEffect
+		return nil
+	case "Recall":
+		*e = RecallEffect
+		return nil
+	case "DivineIntervention":
+		*e = DivineInterventionEffect
+		return nil
+	case "AlmsiviIntervention":
+		*e = AlmsiviInterventionEffect
+		return nil
+	case "DetectAnimal":
+		*e = DetectAnimalEffect
+		return nil
+	case "DetectEnchantment":
+		*e = DetectEnchantmentEffect
+		return nil
+	case "DetectKey":
+		*e = DetectKeyEffect
+		return nil
+	case "SpellAbsorption":
+		*e = SpellAbsorptionEffect
+		return nil
+	case "Reflect":
+		*e = ReflectEffect
+		return nil
+	case "CureCommonDisease":
+		*e = CureCommonDiseaseEffect
+		return nil
+	case "CureBlightDisease":
+		*e = CureBlightDiseaseEffect
+		return nil
+	case "CureCorprusDisease":
+		*e = CureCorprusDiseaseEffect
+		return nil
+	case "CurePoison":
+		*e = CurePoisonEffect
+		return nil
+	case "CureParalyzation":
+		*e = CureParalyzationEffect
+		return nil
+	case "RestoreAttribute":
+		*e = RestoreAttributeEffect
+		return nil
+	case "RestoreHealth":
+		*e = RestoreHealthEffect
+		return nil
+	case "RestoreMagicka":
+		*e = RestoreMagickaEffect
+		return nil
+	case "RestoreFatigue":
+		*e = RestoreFatigueEffect
+		return nil
+	case "RestoreSkill":
+		*e = RestoreSkillEffect
+		return nil
+	case "FortifyAttribute":
+		*e = FortifyAttributeEffect
+		return nil
+	case "FortifyHealth":
+		*e = FortifyHealthEffect
+		return nil
+	case "FortifyMagicka":
+		*e = FortifyMagickaEffect
+		return nil
+	case "FortifyFatigue":
+		*e = FortifyFatigueEffect
+		return nil
+	case "FortifySkill":
+		*e = FortifySkillEffect
+		return nil
+	case "FortifyMaximumMagicka":
+		*e = FortifyMaximumMagickaEffect
+		return nil
+	case "AbsorbAttribute":
+		*e = AbsorbAttributeEffect
+		return nil
+	case "AbsorbHealth":
+		*e = AbsorbHealthEffect
+		return nil
+	case "AbsorbMagicka":
+		*e = AbsorbMagickaEffect
+		return nil
+	case "AbsorbFatigue":
+		*e = AbsorbFatigueEffect
+		return nil
+	case "AbsorbSkill":
+		*e = AbsorbSkillEffect
+		return nil
+	case "ResistFire":
+		*e = ResistFireEffect
+		return nil
+	case "ResistFrost":
+		*e = ResistFrostEffect
+		return nil
+	case "ResistShock":
+		*e = ResistShockEffect
+		return nil
+	case "ResistMagicka":
+		*e = ResistMagickaEffect
+		return nil
+	case "ResistCommonDisease":
+		*e = ResistCommonDiseaseEffect
+		return nil
+	case "ResistBlightDisease":
+		*e = ResistBlightDiseaseEffect
+		return nil
+	case "ResistCorprusDisease":
+		*e = ResistCorprusDiseaseEffect
+		return nil
+	case "ResistPoison":
+		*e = ResistPoisonEffect
+		return nil
+	case "ResistNormalWeapons":
+		*e = ResistNormalWeaponsEffect
+		return nil
+	case "ResistParalysis":
+		*e = ResistParalysisEffect
+		return nil
+	case "RemoveCurse":
+		*e = RemoveCurseEffect
+		return nil
+	case "TurnUndead":
+		*e = TurnUndeadEffect
+		return nil
+	case "SummonScamp":
+		*e = SummonScampEffect
+		return nil
+	case "SummonClannfear":
+		*e = SummonClannfearEffect
+		return nil
+	case "SummonDaedroth":
+		*e = SummonDaedrothEffect
+		return nil
+	case "SummonDremora":
+		*e = SummonDremoraEffect
+		return nil
+	case "SummonAncestralGhost":
+		*e = SummonAncestralGhostEffect
+		return nil
+	case "SummonSkeletalMinion":
+		*e = SummonSkeletalMinionEffect
+		return nil
+	case "SummonBonewalker":
+		*e = SummonBonewalkerEffect
+		return nil
+	case "SummonGreaterBonewalker":
+		*e = SummonGreaterBonewalkerEffect
+		return nil
+	case "SummonBonelord":
+		*e = SummonBonelordEffect
+		return nil
+	case "SummonWingedTwilight":
+		*e = SummonWingedTwilightEffect
+		return nil
+	case "SummonHunger":
+		*e = SummonHungerEffect
+		return nil
+	case "SummonGoldenSaint":
+		*e = SummonGoldenSaintEffect
+		return nil
+	case "SummonFlameAtronach":
+		*e = SummonFlameAtronachEffect
+		return nil
+	case "SummonFrostAtronach":
+		*e = SummonFrostAtronachEffect
+		return nil
+	case "SummonStormAtronach":
+		*e = SummonStormAtronachEffect
+		return nil
+	case "FortifyAttack":
+		*e = FortifyAttackEffect
+		return nil
+	case "CommandCreature":
+		*e = CommandCreatureEffect
+		return nil
+	case "CommandHumanoid":
+		*e = CommandHumanoidEffect
+		return nil
+	case "BoundDagger":
+		*e = BoundDaggerEffect
+		return nil
+	case "BoundLongsword":
+		*e = BoundLongswordEffect
+		return nil
+	case "BoundMace":
+		*e = BoundMaceEffect
+		return nil
+	case "BoundBattleAxe":
+		*e = BoundBattleAxeEffect
+		return nil
+	case "BoundSpear":
+		*e = BoundSpearEffect
+		return nil
+	case "BoundLongbow":
+		*e = BoundLongbowEffect
+		return nil
+	case "ExtraSpell":
+		*e = ExtraSpellEffect
+		return nil
+	case "BoundCuirass":
+		*e = BoundCuirassEffect
+		return nil
+	case "BoundHelm":
+		*e = BoundHelmEffect
+		return nil
+	case "BoundBoots":
+		*e = BoundBootsEffect
+		return nil
+	case "BoundShield":
+		*e = BoundShieldEffect
+		return nil
+	case "BoundGloves":
+		*e = BoundGlovesEffect
+		return nil
+	case "Corprus":
+		*e = CorprusEffect
+		return nil
+	case "Vampirism":
+		*e = VampirismEffect
+		return nil
+	case "SummonCenturionSphere":
+		*e = SummonCenturionSphereEffect
+		return nil
+	case "SunDamage":
+		*e = SunDamageEffect
+		return nil
+	case "StuntedMagicka":
+		*e = StuntedMagickaEffect
+		return nil
+	case "SummonFabricant":
+		*e = SummonFabricantEffect
+		return nil
+	case "SummonWolf":
+		*e = SummonWolfEffect
+		return nil
+	case "SummonBear":
+		*e = SummonBearEffect
+		return nil
+	case "SummonBonewolf":
+		*e = SummonBonewolfEffect
+		return nil
+	case "SummonCreature04":
+		*e = SummonCreature04Effect
+		return nil
+	case "SummonCreature05":
+		*e = SummonCreature05Effect
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*2)
+	if err != nil {
+		return fmt.Errorf("unknown Effect %q", text)
+	}
+	*e = Effect(v)
+	return nil
+}
+
+// Range is who a magic effect applies to.
+type Range uint32
+
+const (
+	SelfRange   Range = 0
+	TouchRange  Range = 1
+	TargetRange Range = 2
+)
+
+func (e Range) String() string {
+	switch e {
+	case SelfRange:
+		return "Self"
+	case TouchRange:
+		return "Touch"
+	case TargetRange:
+		return "Target"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Range) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Range) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Self":
+		*e = SelfRange
+		return nil
+	case "Touch":
+		*e = TouchRange
+		return nil
+	case "Target":
+		*e = TargetRange
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*4)
+	if err != nil {
+		return fmt.Errorf("unknown Range %q", text)
+	}
+	*e = Range(v)
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package magic
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("ENAM", func(t *testing.T) {
+		for range 32 {
+			s := &ENAMField{}
+			s.Effect = Effect(r.Uint64())
+			s.Skill = int8(r.Uint64())
+			s.Attribute = int8(r.Uint64())
+			s.Range = Range(r.Uint64())
+			s.Area = int32(r.Uint64())
+			s.Duration = int32(r.Uint64())
+			s.MagnitudeMin = int32(r.Uint64())
+			s.MagnitudeMax = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("Effect", func(t *testing.T) {
+		for range 32 {
+			want := Effect(r.Uint64())
+			var got Effect
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("Range", func(t *testing.T) {
+		for range 32 {
+			want := Range(r.Uint64())
+			var got Range
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+}
@@ -0,0 +1,63 @@
+// MGEF records contain magic effects.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package mgef
+
+import (
+	"strconv"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+)
+
+// MGEF handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/MGEF
+const MGEF esm.RecordTag = "MGEF"
+
+func init() {
+	esm.RegisterRecord(MGEF, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		m, err := ParseMagicEffect(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	})
+	// ENAM subrecords refer to magic effects by index.
+	esm.RegisterIdentity(MGEF, func(rec *esm.Record) (string, error) {
+		indx := &INDXField{}
+		if err := rec.UnmarshalFirst(indx); err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(uint64(indx.Effect), 10), nil
+	})
+	esm.RegisterSubrecords(MGEF,
+		&INDXField{},
+		&MEDTField{},
+		&ITEXField{},
+		&PTEXField{},
+		&BSNDField{},
+		&CSNDField{},
+		&HSNDField{},
+		&ASNDField{},
+		&CVFXField{},
+		&BVFXField{},
+		&HVFXField{},
+		&AVFXField{},
+		&DESCField{},
+	)
+}
+
+// BaseCosts returns a lookup of the base costs of effects, for
+// magic.TotalCost. Later records replace earlier ones with the same index.
+func BaseCosts(effects ...*MagicEffectRecord) func(magic.Effect) (float32, bool) {
+	costs := map[magic.Effect]float32{}
+	for _, m := range effects {
+		if m.INDX != nil && m.MEDT != nil {
+			costs[m.INDX.Effect] = m.MEDT.BaseCost
+		}
+	}
+	return func(e magic.Effect) (float32, bool) {
+		cost, ok := costs[e]
+		return cost, ok
+	}
+}
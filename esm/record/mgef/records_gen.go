@@ -0,0 +1,202 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package mgef
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// MagicEffectRecord is a magic effect. Unlike most records it is identified by index, not by name.
+type MagicEffectRecord struct {
+	INDX *INDXField
+	MEDT *MEDTField
+	ITEX *ITEXField
+	PTEX *PTEXField
+	BSND *BSNDField
+	CSND *CSNDField
+	HSND *HSNDField
+	ASND *ASNDField
+	CVFX *CVFXField
+	BVFX *BVFXField
+	HVFX *HVFXField
+	AVFX *AVFXField
+	DESC *DESCField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// magicEffectRecordFields lists the tag that starts each field of MagicEffectRecord.
+var magicEffectRecordFields = []esm.SubrecordTag{INDX, MEDT, ITEX, PTEX, BSND, CSND, HSND, ASND, CVFX, BVFX, HVFX, AVFX, DESC}
+
+func (r *MagicEffectRecord) Tag() esm.RecordTag { return MGEF }
+
+func (r *MagicEffectRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.INDX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MEDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ITEX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.PTEX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.BSND); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CSND); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.HSND); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ASND); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CVFX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.BVFX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.HVFX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.AVFX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.DESC); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseMagicEffect builds a MagicEffectRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseMagicEffect(rec *esm.Record, opts ...esm.ParseOption) (*MagicEffectRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != MGEF {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseMagicEffectRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseMagicEffectRecord parses the MagicEffectRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseMagicEffectRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*MagicEffectRecord, int, error) {
+	r := &MagicEffectRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(magicEffectRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.INDX != nil {
+				f.Keep(i)
+				break
+			}
+			r.INDX, err = esm.ParseField[INDXField](f, i)
+		case 1:
+			if r.MEDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.MEDT, err = esm.ParseField[MEDTField](f, i)
+		case 2:
+			if r.ITEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.ITEX, err = esm.ParseField[ITEXField](f, i)
+		case 3:
+			if r.PTEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.PTEX, err = esm.ParseField[PTEXField](f, i)
+		case 4:
+			if r.BSND != nil {
+				f.Keep(i)
+				break
+			}
+			r.BSND, err = esm.ParseField[BSNDField](f, i)
+		case 5:
+			if r.CSND != nil {
+				f.Keep(i)
+				break
+			}
+			r.CSND, err = esm.ParseField[CSNDField](f, i)
+		case 6:
+			if r.HSND != nil {
+				f.Keep(i)
+				break
+			}
+			r.HSND, err = esm.ParseField[HSNDField](f, i)
+		case 7:
+			if r.ASND != nil {
+				f.Keep(i)
+				break
+			}
+			r.ASND, err = esm.ParseField[ASNDField](f, i)
+		case 8:
+			if r.CVFX != nil {
+				f.Keep(i)
+				break
+			}
+			r.CVFX, err = esm.ParseField[CVFXField](f, i)
+		case 9:
+			if r.BVFX != nil {
+				f.Keep(i)
+				break
+			}
+			r.BVFX, err = esm.ParseField[BVFXField](f, i)
+		case 10:
+			if r.HVFX != nil {
+				f.Keep(i)
+				break
+			}
+			r.HVFX, err = esm.ParseField[HVFXField](f, i)
+		case 11:
+			if r.AVFX != nil {
+				f.Keep(i)
+				break
+			}
+			r.AVFX, err = esm.ParseField[AVFXField](f, i)
+		case 12:
+			if r.DESC != nil {
+				f.Keep(i)
+				break
+			}
+			r.DESC, err = esm.ParseField[DESCField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.INDX == nil {
+		if err := f.Missing(INDX); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
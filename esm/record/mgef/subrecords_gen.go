@@ -0,0 +1,788 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package mgef
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+)
+
+// Bolt visual ID.
+const BVFX esm.SubrecordTag = "BVFX"
+
+// Bolt visual ID.
+type BVFXField struct{ Value string }
+
+func (t *BVFXField) Tag() esm.SubrecordTag { return BVFX }
+
+// Layout implements esm.Structured.
+func (t *BVFXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *BVFXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *BVFXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode BVFX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Area sound ID.
+const ASND esm.SubrecordTag = "ASND"
+
+// Area sound ID.
+type ASNDField struct{ Value string }
+
+func (t *ASNDField) Tag() esm.SubrecordTag { return ASND }
+
+// Layout implements esm.Structured.
+func (t *ASNDField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ASNDField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ASNDField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ASND: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Cast sound ID.
+const CSND esm.SubrecordTag = "CSND"
+
+// Cast sound ID.
+type CSNDField struct{ Value string }
+
+func (t *CSNDField) Tag() esm.SubrecordTag { return CSND }
+
+// Layout implements esm.Structured.
+func (t *CSNDField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CSNDField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *CSNDField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CSND: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Area visual ID.
+const AVFX esm.SubrecordTag = "AVFX"
+
+// Area visual ID.
+type AVFXField struct{ Value string }
+
+func (t *AVFXField) Tag() esm.SubrecordTag { return AVFX }
+
+// Layout implements esm.Structured.
+func (t *AVFXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *AVFXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *AVFXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode AVFX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Description.
+const DESC esm.SubrecordTag = "DESC"
+
+// Description.
+type DESCField struct{ Value string }
+
+func (t *DESCField) Tag() esm.SubrecordTag { return DESC }
+
+// Layout implements esm.Structured.
+func (t *DESCField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *DESCField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	s.Value = util.DecodeString(sub.Data)
+
+	return nil
+}
+
+func (s *DESCField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode DESC: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: raw}, nil
+}
+
+// Casting visual ID.
+const CVFX esm.SubrecordTag = "CVFX"
+
+// Casting visual ID.
+type CVFXField struct{ Value string }
+
+func (t *CVFXField) Tag() esm.SubrecordTag { return CVFX }
+
+// Layout implements esm.Structured.
+func (t *CVFXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CVFXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *CVFXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CVFX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Hit sound ID.
+const HSND esm.SubrecordTag = "HSND"
+
+// Hit sound ID.
+type HSNDField struct{ Value string }
+
+func (t *HSNDField) Tag() esm.SubrecordTag { return HSND }
+
+// Layout implements esm.Structured.
+func (t *HSNDField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *HSNDField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *HSNDField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode HSND: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Index of the effect.
+const INDX esm.SubrecordTag = "INDX"
+
+// Index of the effect.
+type INDXField struct {
+	Effect magic.Effect
+}
+
+func (t *INDXField) Tag() esm.SubrecordTag { return INDX }
+
+// Size implements esm.Sized.
+func (t *INDXField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *INDXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Effect", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *INDXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("INDX must be 4 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Effect = magic.Effect(binary.LittleEndian.Uint32(d[0:4]))
+	return nil
+}
+
+func (s *INDXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 4)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.Effect))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Particle texture filename.
+const PTEX esm.SubrecordTag = "PTEX"
+
+// Particle texture filename.
+type PTEXField struct{ Value string }
+
+func (t *PTEXField) Tag() esm.SubrecordTag { return PTEX }
+
+// Layout implements esm.Structured.
+func (t *PTEXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *PTEXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *PTEXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode PTEX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Icon filename.
+const ITEX esm.SubrecordTag = "ITEX"
+
+// Icon filename.
+type ITEXField struct{ Value string }
+
+func (t *ITEXField) Tag() esm.SubrecordTag { return ITEX }
+
+// Layout implements esm.Structured.
+func (t *ITEXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ITEXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ITEXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ITEX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Magic effect data.
+const MEDT esm.SubrecordTag = "MEDT"
+
+// Magic effect data.
+type MEDTField struct {
+	School   School
+	BaseCost float32
+	Flags    Flags
+	Red      int32
+	Green    int32
+	Blue     int32
+	// Projectile speed multiplier.
+	SpeedX  float32
+	SizeX   float32
+	SizeCap float32
+}
+
+func (t *MEDTField) Tag() esm.SubrecordTag { return MEDT }
+
+// Size implements esm.Sized.
+func (t *MEDTField) Size() int { return 36 }
+
+// Layout implements esm.Structured.
+func (t *MEDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "School", Type: "u32", Offset: 0, Size: 4},
+		{Name: "BaseCost", Type: "f32", Offset: 4, Size: 4},
+		{Name: "Flags", Type: "u32", Offset: 8, Size: 4},
+		{Name: "Red", Type: "i32", Offset: 12, Size: 4},
+		{Name: "Green", Type: "i32", Offset: 16, Size: 4},
+		{Name: "Blue", Type: "i32", Offset: 20, Size: 4},
+		{Name: "SpeedX", Type: "f32", Offset: 24, Size: 4},
+		{Name: "SizeX", Type: "f32", Offset: 28, Size: 4},
+		{Name: "SizeCap", Type: "f32", Offset: 32, Size: 4},
+	}
+}
+
+func (s *MEDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 36 {
+		return fmt.Errorf("MEDT must be 36 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.School = School(binary.LittleEndian.Uint32(d[0:4]))
+	s.BaseCost = math.Float32frombits(binary.LittleEndian.Uint32(d[4:8]))
+	s.Flags = Flags(binary.LittleEndian.Uint32(d[8:12]))
+	s.Red = int32(binary.LittleEndian.Uint32(d[12:16]))
+	s.Green = int32(binary.LittleEndian.Uint32(d[16:20]))
+	s.Blue = int32(binary.LittleEndian.Uint32(d[20:24]))
+	s.SpeedX = math.Float32frombits(binary.LittleEndian.Uint32(d[24:28]))
+	s.SizeX = math.Float32frombits(binary.LittleEndian.Uint32(d[28:32]))
+	s.SizeCap = math.Float32frombits(binary.LittleEndian.Uint32(d[32:36]))
+	return nil
+}
+
+func (s *MEDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 36)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.School))
+	binary.LittleEndian.PutUint32(d[4:8], math.Float32bits(float32(s.BaseCost)))
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.Flags))
+	binary.LittleEndian.PutUint32(d[12:16], uint32(s.Red))
+	binary.LittleEndian.PutUint32(d[16:20], uint32(s.Green))
+	binary.LittleEndian.PutUint32(d[20:24], uint32(s.Blue))
+	binary.LittleEndian.PutUint32(d[24:28], math.Float32bits(float32(s.SpeedX)))
+	binary.LittleEndian.PutUint32(d[28:32], math.Float32bits(float32(s.SizeX)))
+	binary.LittleEndian.PutUint32(d[32:36], math.Float32bits(float32(s.SizeCap)))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Hit visual ID.
+const HVFX esm.SubrecordTag = "HVFX"
+
+// Hit visual ID.
+type HVFXField struct{ Value string }
+
+func (t *HVFXField) Tag() esm.SubrecordTag { return HVFX }
+
+// Layout implements esm.Structured.
+func (t *HVFXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *HVFXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *HVFXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode HVFX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Bolt sound ID.
+const BSND esm.SubrecordTag = "BSND"
+
+// Bolt sound ID.
+type BSNDField struct{ Value string }
+
+func (t *BSNDField) Tag() esm.SubrecordTag { return BSND }
+
+// Layout implements esm.Structured.
+func (t *BSNDField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *BSNDField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *BSNDField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode BSND: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Flags are magic effect flags, from MEDT. The game sets the others by effect index, whatever the file says.
+type Flags uint32
+
+const (
+	// Players can make spells with it.
+	SpellmakingFlag Flags = 0x200
+	// Players can enchant items with it.
+	EnchantingFlag Flags = 0x400
+	// Darkens instead of lighting, for Light.
+	NegativeFlag Flags = 0x800
+)
+
+// flagsNames lists the named bits of Flags, in order.
+var flagsNames = []struct {
+	flag Flags
+	name string
+}{
+	{SpellmakingFlag, "Spellmaking"},
+	{EnchantingFlag, "Enchanting"},
+	{NegativeFlag, "Negative"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f Flags) Has(flag Flags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *Flags) Set(flag Flags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f Flags) Names() []string {
+	names := []string{}
+	for _, n := range flagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseFlags combines bit names, as returned by Names, into a Flags.
+// Numbers are accepted too.
+func ParseFlags(names []string) (Flags, error) {
+	var f Flags
+next:
+	for _, name := range names {
+		for _, n := range flagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown Flags %q", name)
+		}
+		f |= Flags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f Flags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *Flags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f Flags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *Flags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// School is the school of magic of an effect, from MEDT.
+type School uint32
+
+const (
+	AlterationSchool  School = 0
+	ConjurationSchool School = 1
+	DestructionSchool School = 2
+	IllusionSchool    School = 3
+	MysticismSchool   School = 4
+	RestorationSchool School = 5
+)
+
+func (e School) String() string {
+	switch e {
+	case AlterationSchool:
+		return "Alteration"
+	case ConjurationSchool:
+		return "Conjuration"
+	case DestructionSchool:
+		return "Destruction"
+	case IllusionSchool:
+		return "Illusion"
+	case MysticismSchool:
+		return "Mysticism"
+	case RestorationSchool:
+		return "Restoration"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e School) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *School) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "Alteration":
+		*e = AlterationSchool
+		return nil
+	case "Conjuration":
+		*e = ConjurationSchool
+		return nil
+	case "Destruction":
+		*e = DestructionSchool
+		return nil
+	case "Illusion":
+		*e = IllusionSchool
+		return nil
+	case "Mysticism":
+		*e = MysticismSchool
+		return nil
+	case "Restoration":
+		*e = RestorationSchool
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*4)
+	if err != nil {
+		return fmt.Errorf("unknown School %q", text)
+	}
+	*e = School(v)
+	return nil
+}
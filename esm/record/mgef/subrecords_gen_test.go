@@ -0,0 +1,111 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package mgef
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("ASND", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ASNDField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("AVFX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &AVFXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("BSND", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &BSNDField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("BVFX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &BVFXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("CSND", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CSNDField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("CVFX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CVFXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("DESC", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &DESCField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Flags", func(t *testing.T) {
+		for range 32 {
+			want := Flags(r.Uint64())
+			got, err := ParseFlags(want.Names())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("HSND", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &HSNDField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("HVFX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &HVFXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("INDX", func(t *testing.T) {
+		for range 32 {
+			s := &INDXField{}
+			s.Effect = magic.Effect(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("ITEX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ITEXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("MEDT", func(t *testing.T) {
+		for range 32 {
+			s := &MEDTField{}
+			s.School = School(r.Uint64())
+			s.BaseCost = gentest.Float32(r)
+			s.Flags = Flags(r.Uint64())
+			s.Red = int32(r.Uint64())
+			s.Green = int32(r.Uint64())
+			s.Blue = int32(r.Uint64())
+			s.SpeedX = gentest.Float32(r)
+			s.SizeX = gentest.Float32(r)
+			s.SizeCap = gentest.Float32(r)
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("PTEX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &PTEXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("School", func(t *testing.T) {
+		for range 32 {
+			want := School(r.Uint64())
+			var got School
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+}
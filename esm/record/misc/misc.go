@@ -0,0 +1,28 @@
+// MISC records contain miscellaneous items.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package misc
+
+import "github.com/ernmw/omwpacker/esm"
+
+// MISC handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/MISC
+const MISC esm.RecordTag = "MISC"
+
+func init() {
+	esm.RegisterRecord(MISC, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		m, err := ParseMisc(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	})
+	esm.RegisterSubrecords(MISC,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&MCDTField{},
+		&SCRIField{},
+		&ITEXField{},
+	)
+}
@@ -0,0 +1,36 @@
+// NPC_ records contain non-player characters.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package npc
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/actor"
+)
+
+// NPC_ handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/NPC_
+const NPC_ esm.RecordTag = "NPC_"
+
+func init() {
+	esm.RegisterRecord(NPC_, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		n, err := ParseNPC(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return n, nil
+	})
+	esm.RegisterSubrecords(NPC_, append([]esm.ParsedSubrecord{
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&RNAMField{},
+		&CNAMField{},
+		&ANAMField{},
+		&BNAMField{},
+		&KNAMField{},
+		&SCRIField{},
+		&NPDTField{},
+		&FLAGField{},
+	}, actor.Subrecords()...)...)
+}
@@ -0,0 +1,72 @@
+package npc
+
+import (
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/ernmw/omwpacker/esm/record/actor"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNPDT(t *testing.T) {
+	for _, size := range []int{52, 12} {
+		raw := make([]byte, size)
+		for i := range raw {
+			raw[i] = byte(i)
+		}
+		var n NPDTField
+		require.NoError(t, (&esm.Subrecord{Tag: NPDT, Data: raw}).UnmarshalTo(&n))
+		require.Equal(t, size == 52, n.Stats != nil)
+		require.Equal(t, size == 12, n.Autocalc != nil)
+		level, err := n.Layout()[0].Value(raw)
+		require.NoError(t, err)
+		require.Equal(t, int16(0x0100), level)
+
+		last := n.Layout()[len(n.Layout())-1]
+		require.Equal(t, "Gold", last.Name)
+		require.Equal(t, size, last.Offset+last.Size)
+
+		sub, err := n.Marshal()
+		require.NoError(t, err)
+		require.Equal(t, NPDT, sub.Tag)
+		require.Equal(t, raw, sub.Data)
+	}
+
+	var n NPDTField
+	require.Error(t, (&esm.Subrecord{Tag: NPDT, Data: make([]byte, 13)}).UnmarshalTo(&n))
+	_, err := (&NPDTField{}).Marshal()
+	require.Error(t, err)
+	_, err = (&NPDTField{Stats: &NPDTStatsField{}, Autocalc: &NPDTAutocalcField{}}).Marshal()
+	require.Error(t, err)
+}
+
+func TestAIPackages(t *testing.T) {
+	rec := &esm.Record{Tag: NPC_, Subrecords: gentest.Marshal(t,
+		&NAMEField{Value: "guard"},
+		&actor.AIDTField{Fight: 30, Services: actor.TrainingService},
+		&actor.AI_TField{X: 1},
+		&actor.AI_FField{Target: "player"},
+		&actor.CNDTField{Value: "Balmora"},
+		&actor.AI_WField{Distance: 64},
+		&actor.AI_EField{Target: "fargoth"},
+		&actor.AI_WField{Distance: 128},
+	)}
+	rec.Subrecords = append(rec.Subrecords, &esm.Subrecord{Tag: esm.DELE, Data: []byte{0, 0, 0, 0}})
+
+	n, err := ParseNPC(rec)
+	require.NoError(t, err)
+	require.Equal(t, []actor.AIPackage{
+		&actor.AITravel{AI_T: &actor.AI_TField{X: 1}},
+		&actor.AIFollow{AI_F: &actor.AI_FField{Target: "player"}, CNDT: &actor.CNDTField{Value: "Balmora"}},
+		&actor.AIWander{AI_W: &actor.AI_WField{Distance: 64}},
+		&actor.AIEscort{AI_E: &actor.AI_EField{Target: "fargoth"}},
+		&actor.AIWander{AI_W: &actor.AI_WField{Distance: 128}},
+	}, n.AIPackages)
+	require.Len(t, n.Unknown, 1)
+	require.True(t, n.AIDT.Services.Has(actor.TrainingService))
+
+	out, err := n.OrderedRecords()
+	require.NoError(t, err)
+	require.Equal(t, rec.Subrecords, out)
+}
@@ -0,0 +1,61 @@
+package npc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// NPDT is the NPC data. Its layout depends on AutocalcFlag.
+const NPDT esm.SubrecordTag = "NPDT"
+
+// NPDTField holds NPC data in the layout it was stored in. Exactly one of
+// the fields is set.
+type NPDTField struct {
+	// Stats is set for NPCs with every stat spelled out (52 bytes).
+	Stats *NPDTStatsField
+	// Autocalc is set for NPCs whose stats the game calculates (12 bytes).
+	Autocalc *NPDTAutocalcField
+}
+
+func (s *NPDTField) Tag() esm.SubrecordTag { return NPDT }
+
+// Layout implements esm.Structured.
+func (s *NPDTField) Layout() esm.Layout {
+	if s.Autocalc != nil {
+		return s.Autocalc.Layout()
+	}
+	return s.Stats.Layout()
+}
+
+func (s *NPDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	*s = NPDTField{}
+	switch len(sub.Data) {
+	case (&NPDTStatsField{}).Size():
+		s.Stats = &NPDTStatsField{}
+		return s.Stats.Unmarshal(sub)
+	case (&NPDTAutocalcField{}).Size():
+		s.Autocalc = &NPDTAutocalcField{}
+		return s.Autocalc.Unmarshal(sub)
+	}
+	return fmt.Errorf("NPDT must be 52 or 12 bytes, got %d", len(sub.Data))
+}
+
+func (s *NPDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	switch {
+	case s.Stats != nil && s.Autocalc != nil:
+		return nil, errors.New("NPDT has both stats and autocalc data")
+	case s.Stats != nil:
+		return s.Stats.Marshal()
+	case s.Autocalc != nil:
+		return s.Autocalc.Marshal()
+	}
+	return nil, errors.New("NPDT has neither stats nor autocalc data")
+}
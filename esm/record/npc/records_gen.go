@@ -0,0 +1,264 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package npc
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/actor"
+)
+
+// NPCRecord is a non-player character.
+type NPCRecord struct {
+	NAME      *NAMEField
+	MODL      *MODLField
+	FNAM      *FNAMField
+	RNAM      *RNAMField
+	CNAM      *CNAMField
+	ANAM      *ANAMField
+	BNAM      *BNAMField
+	KNAM      *KNAMField
+	SCRI      *SCRIField
+	NPDT      *NPDTField
+	FLAG      *FLAGField
+	Inventory []*actor.NPCOField
+	Spells    []*actor.NPCSField
+	AIDT      *actor.AIDTField
+	// Places the NPC offers travel to.
+	Destinations []*actor.TravelDestination
+	// AI packages, in order.
+	AIPackages []actor.AIPackage
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// nPCRecordFields lists the tag that starts each field of NPCRecord.
+var nPCRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, RNAM, CNAM, ANAM, BNAM, KNAM, SCRI, NPDT, FLAG, actor.NPCO, actor.NPCS, actor.AIDT, actor.DODT, actor.AI_W, actor.AI_T, actor.AI_F, actor.AI_E, actor.AI_A}
+
+func (r *NPCRecord) Tag() esm.RecordTag { return NPC_ }
+
+func (r *NPCRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.RNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.CNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ANAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.BNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.KNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.NPDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FLAG); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Inventory {
+		if out, err = esm.AppendMarshalled(out, f); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range r.Spells {
+		if out, err = esm.AppendMarshalled(out, f); err != nil {
+			return nil, err
+		}
+	}
+	if out, err = esm.AppendMarshalled(out, r.AIDT); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Destinations {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	for _, f := range r.AIPackages {
+		if out, err = esm.AppendOrdered(out, f); err != nil {
+			return nil, err
+		}
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseNPC builds a NPCRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseNPC(rec *esm.Record, opts ...esm.ParseOption) (*NPCRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != NPC_ {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseNPCRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseNPCRecord parses the NPCRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseNPCRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*NPCRecord, int, error) {
+	r := &NPCRecord{
+		Inventory:    []*actor.NPCOField{},
+		Spells:       []*actor.NPCSField{},
+		Destinations: []*actor.TravelDestination{},
+		AIPackages:   []actor.AIPackage{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(nPCRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.RNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.RNAM, err = esm.ParseField[RNAMField](f, i)
+		case 4:
+			if r.CNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.CNAM, err = esm.ParseField[CNAMField](f, i)
+		case 5:
+			if r.ANAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.ANAM, err = esm.ParseField[ANAMField](f, i)
+		case 6:
+			if r.BNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.BNAM, err = esm.ParseField[BNAMField](f, i)
+		case 7:
+			if r.KNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.KNAM, err = esm.ParseField[KNAMField](f, i)
+		case 8:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		case 9:
+			if r.NPDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.NPDT, err = esm.ParseField[NPDTField](f, i)
+		case 10:
+			if r.FLAG != nil {
+				f.Keep(i)
+				break
+			}
+			r.FLAG, err = esm.ParseField[FLAGField](f, i)
+		case 11:
+			var v *actor.NPCOField
+			if v, err = esm.ParseField[actor.NPCOField](f, i); v != nil {
+				r.Inventory = append(r.Inventory, v)
+			}
+		case 12:
+			var v *actor.NPCSField
+			if v, err = esm.ParseField[actor.NPCSField](f, i); v != nil {
+				r.Spells = append(r.Spells, v)
+			}
+		case 13:
+			if r.AIDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.AIDT, err = esm.ParseField[actor.AIDTField](f, i)
+		case 14:
+			var g *actor.TravelDestination
+			if g, consumed, err = actor.ParseTravelDestination(rec, i, o); err == nil {
+				r.Destinations = append(r.Destinations, g)
+			}
+		case 15:
+			var g *actor.AIWander
+			if g, consumed, err = actor.ParseAIWander(rec, i, o); err == nil {
+				r.AIPackages = append(r.AIPackages, g)
+			}
+		case 16:
+			var g *actor.AITravel
+			if g, consumed, err = actor.ParseAITravel(rec, i, o); err == nil {
+				r.AIPackages = append(r.AIPackages, g)
+			}
+		case 17:
+			var g *actor.AIFollow
+			if g, consumed, err = actor.ParseAIFollow(rec, i, o); err == nil {
+				r.AIPackages = append(r.AIPackages, g)
+			}
+		case 18:
+			var g *actor.AIEscort
+			if g, consumed, err = actor.ParseAIEscort(rec, i, o); err == nil {
+				r.AIPackages = append(r.AIPackages, g)
+			}
+		case 19:
+			var g *actor.AIActivate
+			if g, consumed, err = actor.ParseAIActivate(rec, i, o); err == nil {
+				r.AIPackages = append(r.AIPackages, g)
+			}
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
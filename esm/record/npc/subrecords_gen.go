@@ -0,0 +1,732 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package npc
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Flags holds the bits of a FLAG subrecord.
+type Flags uint32
+
+const (
+	FemaleFlag    Flags = 0x01
+	EssentialFlag Flags = 0x02
+	RespawnFlag   Flags = 0x04
+	// Stats are calculated by the game; NPDT is the 12 byte layout.
+	AutocalcFlag Flags = 0x10
+	// Bleeds skeleton blood (white).
+	SkeletonBloodFlag Flags = 0x400
+	// Bleeds metal sparks (gold).
+	MetalBloodFlag Flags = 0x800
+)
+
+// flagsNames lists the named bits of Flags, in order.
+var flagsNames = []struct {
+	flag Flags
+	name string
+}{
+	{FemaleFlag, "Female"},
+	{EssentialFlag, "Essential"},
+	{RespawnFlag, "Respawn"},
+	{AutocalcFlag, "Autocalc"},
+	{SkeletonBloodFlag, "SkeletonBlood"},
+	{MetalBloodFlag, "MetalBlood"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f Flags) Has(flag Flags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *Flags) Set(flag Flags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f Flags) Names() []string {
+	names := []string{}
+	for _, n := range flagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseFlags combines bit names, as returned by Names, into a Flags.
+// Numbers are accepted too.
+func ParseFlags(names []string) (Flags, error) {
+	var f Flags
+next:
+	for _, name := range names {
+		for _, n := range flagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown Flags %q", name)
+		}
+		f |= Flags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f Flags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *Flags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f Flags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *Flags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// NPC flags.
+const FLAG esm.SubrecordTag = "FLAG"
+
+// NPC flags.
+type FLAGField struct{ Value Flags }
+
+func (t *FLAGField) Tag() esm.SubrecordTag { return FLAG }
+
+// Size implements esm.Sized.
+func (t *FLAGField) Size() int { return 4 }
+
+// Layout implements esm.Structured.
+func (t *FLAGField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "u32", Offset: 0, Size: 4},
+	}
+}
+
+func (s *FLAGField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 4 {
+		return fmt.Errorf("FLAG must be 4 bytes, got %d", len(sub.Data))
+	}
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, &s.Value)
+	return err
+}
+
+func (s *FLAGField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := binary.Append(nil, binary.LittleEndian, s.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
+
+// NPC data for NPCs whose stats the game calculates. Stored as NPDT.
+const NPDTAutocalc esm.SubrecordTag = "NPDT"
+
+// NPC data for NPCs whose stats the game calculates. Stored as NPDT.
+type NPDTAutocalcField struct {
+	Level       int16
+	Disposition uint8
+	Reputation  uint8
+	Rank        uint8
+	Unknown     [3]uint8
+	Gold        int32
+}
+
+func (t *NPDTAutocalcField) Tag() esm.SubrecordTag { return NPDTAutocalc }
+
+// Size implements esm.Sized.
+func (t *NPDTAutocalcField) Size() int { return 12 }
+
+// Layout implements esm.Structured.
+func (t *NPDTAutocalcField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Level", Type: "i16", Offset: 0, Size: 2},
+		{Name: "Disposition", Type: "u8", Offset: 2, Size: 1},
+		{Name: "Reputation", Type: "u8", Offset: 3, Size: 1},
+		{Name: "Rank", Type: "u8", Offset: 4, Size: 1},
+		{Name: "Unknown", Type: "u8", Offset: 5, Size: 3, Count: 3},
+		{Name: "Gold", Type: "i32", Offset: 8, Size: 4},
+	}
+}
+
+func (s *NPDTAutocalcField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 12 {
+		return fmt.Errorf("NPDT must be 12 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Level = int16(binary.LittleEndian.Uint16(d[0:2]))
+	s.Disposition = d[2]
+	s.Reputation = d[3]
+	s.Rank = d[4]
+	for i := range s.Unknown {
+		s.Unknown[i] = d[5+i]
+	}
+	s.Gold = int32(binary.LittleEndian.Uint32(d[8:12]))
+	return nil
+}
+
+func (s *NPDTAutocalcField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 12)
+	binary.LittleEndian.PutUint16(d[0:2], uint16(s.Level))
+	d[2] = byte(s.Disposition)
+	d[3] = byte(s.Reputation)
+	d[4] = byte(s.Rank)
+	for i := range s.Unknown {
+		d[5+i] = byte(s.Unknown[i])
+	}
+	binary.LittleEndian.PutUint32(d[8:12], uint32(s.Gold))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// NPC data with every stat spelled out. Stored as NPDT.
+const NPDTStats esm.SubrecordTag = "NPDT"
+
+// NPC data with every stat spelled out. Stored as NPDT.
+type NPDTStatsField struct {
+	Level int16
+	// Strength, Intelligence, Willpower, Agility, Speed, Endurance, Personality, Luck.
+	Attributes [8]uint8
+	// Skills, in skill index order.
+	Skills      [27]uint8
+	Unknown1    uint8
+	Health      uint16
+	SpellPoints uint16
+	Fatigue     uint16
+	Disposition uint8
+	Reputation  uint8
+	Rank        uint8
+	Unknown2    uint8
+	Gold        int32
+}
+
+func (t *NPDTStatsField) Tag() esm.SubrecordTag { return NPDTStats }
+
+// Size implements esm.Sized.
+func (t *NPDTStatsField) Size() int { return 52 }
+
+// Layout implements esm.Structured.
+func (t *NPDTStatsField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Level", Type: "i16", Offset: 0, Size: 2},
+		{Name: "Attributes", Type: "u8", Offset: 2, Size: 8, Count: 8},
+		{Name: "Skills", Type: "u8", Offset: 10, Size: 27, Count: 27},
+		{Name: "Unknown1", Type: "u8", Offset: 37, Size: 1},
+		{Name: "Health", Type: "u16", Offset: 38, Size: 2},
+		{Name: "SpellPoints", Type: "u16", Offset: 40, Size: 2},
+		{Name: "Fatigue", Type: "u16", Offset: 42, Size: 2},
+		{Name: "Disposition", Type: "u8", Offset: 44, Size: 1},
+		{Name: "Reputation", Type: "u8", Offset: 45, Size: 1},
+		{Name: "Rank", Type: "u8", Offset: 46, Size: 1},
+		{Name: "Unknown2", Type: "u8", Offset: 47, Size: 1},
+		{Name: "Gold", Type: "i32", Offset: 48, Size: 4},
+	}
+}
+
+func (s *NPDTStatsField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 52 {
+		return fmt.Errorf("NPDT must be 52 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Level = int16(binary.LittleEndian.Uint16(d[0:2]))
+	for i := range s.Attributes {
+		s.Attributes[i] = d[2+i]
+	}
+	for i := range s.Skills {
+		s.Skills[i] = d[10+i]
+	}
+	s.Unknown1 = d[37]
+	s.Health = binary.LittleEndian.Uint16(d[38:40])
+	s.SpellPoints = binary.LittleEndian.Uint16(d[40:42])
+	s.Fatigue = binary.LittleEndian.Uint16(d[42:44])
+	s.Disposition = d[44]
+	s.Reputation = d[45]
+	s.Rank = d[46]
+	s.Unknown2 = d[47]
+	s.Gold = int32(binary.LittleEndian.Uint32(d[48:52]))
+	return nil
+}
+
+func (s *NPDTStatsField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 52)
+	binary.LittleEndian.PutUint16(d[0:2], uint16(s.Level))
+	for i := range s.Attributes {
+		d[2+i] = byte(s.Attributes[i])
+	}
+	for i := range s.Skills {
+		d[10+i] = byte(s.Skills[i])
+	}
+	d[37] = byte(s.Unknown1)
+	binary.LittleEndian.PutUint16(d[38:40], uint16(s.Health))
+	binary.LittleEndian.PutUint16(d[40:42], uint16(s.SpellPoints))
+	binary.LittleEndian.PutUint16(d[42:44], uint16(s.Fatigue))
+	d[44] = byte(s.Disposition)
+	d[45] = byte(s.Reputation)
+	d[46] = byte(s.Rank)
+	d[47] = byte(s.Unknown2)
+	binary.LittleEndian.PutUint32(d[48:52], uint32(s.Gold))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Hair body part ID.
+const KNAM esm.SubrecordTag = "KNAM"
+
+// Hair body part ID.
+type KNAMField struct{ Value string }
+
+func (t *KNAMField) Tag() esm.SubrecordTag { return KNAM }
+
+// Layout implements esm.Structured.
+func (t *KNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *KNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *KNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode KNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Head body part ID.
+const BNAM esm.SubrecordTag = "BNAM"
+
+// Head body part ID.
+type BNAMField struct{ Value string }
+
+func (t *BNAMField) Tag() esm.SubrecordTag { return BNAM }
+
+// Layout implements esm.Structured.
+func (t *BNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *BNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *BNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode BNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Faction ID. Empty for NPCs without a faction.
+const ANAM esm.SubrecordTag = "ANAM"
+
+// Faction ID. Empty for NPCs without a faction.
+type ANAMField struct{ Value string }
+
+func (t *ANAMField) Tag() esm.SubrecordTag { return ANAM }
+
+// Layout implements esm.Structured.
+func (t *ANAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ANAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ANAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ANAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Class ID.
+const CNAM esm.SubrecordTag = "CNAM"
+
+// Class ID.
+type CNAMField struct{ Value string }
+
+func (t *CNAMField) Tag() esm.SubrecordTag { return CNAM }
+
+// Layout implements esm.Structured.
+func (t *CNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *CNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *CNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode CNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Race ID.
+const RNAM esm.SubrecordTag = "RNAM"
+
+// Race ID.
+type RNAMField struct{ Value string }
+
+func (t *RNAMField) Tag() esm.SubrecordTag { return RNAM }
+
+// Layout implements esm.Structured.
+func (t *RNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *RNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *RNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode RNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// NPC name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// NPC name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// NPC ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// NPC ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,100 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package npc
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("ANAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ANAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("BNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &BNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("CNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &CNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Flags", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FLAGField{Value: Flags(r.Uint64())})
+		}
+	})
+	t.Run("KNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &KNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("MODL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MODLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NPDTAutocalc", func(t *testing.T) {
+		for range 32 {
+			s := &NPDTAutocalcField{}
+			s.Level = int16(r.Uint64())
+			s.Disposition = uint8(r.Uint64())
+			s.Reputation = uint8(r.Uint64())
+			s.Rank = uint8(r.Uint64())
+			for i := range s.Unknown {
+				s.Unknown[i] = uint8(r.Uint64())
+			}
+			s.Gold = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("NPDTStats", func(t *testing.T) {
+		for range 32 {
+			s := &NPDTStatsField{}
+			s.Level = int16(r.Uint64())
+			for i := range s.Attributes {
+				s.Attributes[i] = uint8(r.Uint64())
+			}
+			for i := range s.Skills {
+				s.Skills[i] = uint8(r.Uint64())
+			}
+			s.Unknown1 = uint8(r.Uint64())
+			s.Health = uint16(r.Uint64())
+			s.SpellPoints = uint16(r.Uint64())
+			s.Fatigue = uint16(r.Uint64())
+			s.Disposition = uint8(r.Uint64())
+			s.Reputation = uint8(r.Uint64())
+			s.Rank = uint8(r.Uint64())
+			s.Unknown2 = uint8(r.Uint64())
+			s.Gold = int32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("RNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &RNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SCRI", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCRIField{Value: gentest.String(r, 64)})
+		}
+	})
+}
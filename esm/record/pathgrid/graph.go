@@ -0,0 +1,256 @@
+package pathgrid
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// Node is a point of a path grid graph.
+type Node struct {
+	X, Y, Z       int32
+	AutoGenerated uint8
+	Unknown       uint16
+}
+
+// Graph is a path grid as nodes and adjacency lists. Edges[i] lists the
+// nodes node i connects to, in the order PGRC stores them. The game
+// stores each connection in both directions.
+type Graph struct {
+	Nodes []Node
+	Edges [][]int
+}
+
+// Graph decodes the points and connections of r. It fails if their counts
+// disagree with DATA and PGRP, or a connection leads past the last point.
+func (r *PathGridRecord) Graph() (*Graph, error) {
+	if r.DATA == nil {
+		return nil, fmt.Errorf("DATA: %w", esm.ErrSubrecordNotFound)
+	}
+	points := []Point{}
+	if r.PGRP != nil {
+		points = r.PGRP.Points
+	}
+	targets := []uint32{}
+	if r.PGRC != nil {
+		targets = r.PGRC.Targets
+	}
+	if len(points) != int(r.DATA.PointCount) {
+		return nil, fmt.Errorf("PGRP has %d points, DATA says %d", len(points), r.DATA.PointCount)
+	}
+	connections := 0
+	for _, p := range points {
+		connections += int(p.Connections)
+	}
+	if connections != len(targets) {
+		return nil, fmt.Errorf("PGRC has %d connections, PGRP says %d", len(targets), connections)
+	}
+
+	g := &Graph{Nodes: make([]Node, len(points)), Edges: make([][]int, len(points))}
+	next := 0
+	for i, p := range points {
+		g.Nodes[i] = Node{X: p.X, Y: p.Y, Z: p.Z, AutoGenerated: p.AutoGenerated, Unknown: p.Unknown}
+		g.Edges[i] = make([]int, p.Connections)
+		for j := range g.Edges[i] {
+			target := targets[next]
+			if target >= uint32(len(points)) {
+				return nil, fmt.Errorf("point %d connects to point %d, but there are %d", i, target, len(points))
+			}
+			g.Edges[i][j] = int(target)
+			next++
+		}
+	}
+	return g, nil
+}
+
+// SetGraph encodes g into the points, connections and point count of r,
+// adding DATA if r has none.
+func (r *PathGridRecord) SetGraph(g *Graph) error {
+	if err := g.validate(); err != nil {
+		return err
+	}
+	if len(g.Nodes) > math.MaxUint16 {
+		return fmt.Errorf("path grid has %d points, the most is %d", len(g.Nodes), math.MaxUint16)
+	}
+	points := make([]Point, len(g.Nodes))
+	targets := []uint32{}
+	for i, n := range g.Nodes {
+		if len(g.Edges[i]) > math.MaxUint8 {
+			return fmt.Errorf("point %d has %d connections, the most is %d", i, len(g.Edges[i]), math.MaxUint8)
+		}
+		points[i] = Point{X: n.X, Y: n.Y, Z: n.Z, AutoGenerated: n.AutoGenerated, Connections: uint8(len(g.Edges[i])), Unknown: n.Unknown}
+		for _, target := range g.Edges[i] {
+			targets = append(targets, uint32(target))
+		}
+	}
+	if r.DATA == nil {
+		r.DATA = &DATAField{}
+	}
+	r.DATA.PointCount = uint16(len(points))
+	r.PGRP = &PGRPField{Points: points}
+	r.PGRC = &PGRCField{Targets: targets}
+	return nil
+}
+
+func (g *Graph) validate() error {
+	if len(g.Edges) != len(g.Nodes) {
+		return fmt.Errorf("graph has %d nodes but %d adjacency lists", len(g.Nodes), len(g.Edges))
+	}
+	for i, edges := range g.Edges {
+		for _, target := range edges {
+			if target < 0 || target >= len(g.Nodes) {
+				return fmt.Errorf("node %d connects to node %d, but there are %d", i, target, len(g.Nodes))
+			}
+		}
+	}
+	return nil
+}
+
+// AddNode adds an unconnected node and returns its index.
+func (g *Graph) AddNode(n Node) int {
+	g.Nodes = append(g.Nodes, n)
+	g.Edges = append(g.Edges, []int{})
+	return len(g.Nodes) - 1
+}
+
+// RemoveNode removes node i and its connections. Later nodes move down
+// one index.
+func (g *Graph) RemoveNode(i int) error {
+	if err := g.check(i); err != nil {
+		return err
+	}
+	g.Nodes = slices.Delete(g.Nodes, i, i+1)
+	g.Edges = slices.Delete(g.Edges, i, i+1)
+	for n, edges := range g.Edges {
+		edges = slices.DeleteFunc(edges, func(target int) bool { return target == i })
+		for j, target := range edges {
+			if target > i {
+				edges[j] = target - 1
+			}
+		}
+		g.Edges[n] = edges
+	}
+	return nil
+}
+
+// Connect connects nodes a and b in both directions, unless they already
+// are.
+func (g *Graph) Connect(a, b int) error {
+	if err := errors.Join(g.check(a), g.check(b)); err != nil {
+		return err
+	}
+	if a == b {
+		return fmt.Errorf("can't connect node %d to itself", a)
+	}
+	if !slices.Contains(g.Edges[a], b) {
+		g.Edges[a] = append(g.Edges[a], b)
+	}
+	if !slices.Contains(g.Edges[b], a) {
+		g.Edges[b] = append(g.Edges[b], a)
+	}
+	return nil
+}
+
+// Disconnect removes the connections between nodes a and b, in both
+// directions.
+func (g *Graph) Disconnect(a, b int) error {
+	if err := errors.Join(g.check(a), g.check(b)); err != nil {
+		return err
+	}
+	g.Edges[a] = slices.DeleteFunc(g.Edges[a], func(target int) bool { return target == b })
+	g.Edges[b] = slices.DeleteFunc(g.Edges[b], func(target int) bool { return target == a })
+	return nil
+}
+
+func (g *Graph) check(i int) error {
+	if i < 0 || i >= len(g.Nodes) {
+		return fmt.Errorf("node %d out of range, there are %d", i, len(g.Nodes))
+	}
+	return nil
+}
+
+// links returns each connection once: the pairs connected both ways with
+// the lower index first, then the ones connected one way only.
+func (g *Graph) links() (both, oneWay [][2]int) {
+	for a, edges := range g.Edges {
+		for _, b := range edges {
+			switch {
+			case !slices.Contains(g.Edges[b], a):
+				oneWay = append(oneWay, [2]int{a, b})
+			case a < b:
+				both = append(both, [2]int{a, b})
+			}
+		}
+	}
+	return both, oneWay
+}
+
+// WriteDOT writes g as a Graphviz graph named name. Nodes are placed at
+// their X and Y, so `neato -n` draws the grid as seen from above.
+func (g *Graph) WriteDOT(w io.Writer, name string) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "digraph %q {\n", name)
+	fmt.Fprintln(bw, "\tnode [shape=point];")
+	for i, n := range g.Nodes {
+		fmt.Fprintf(bw, "\t%d [pos=\"%d,%d\", z=%d];\n", i, n.X, n.Y, n.Z)
+	}
+	both, oneWay := g.links()
+	for _, l := range both {
+		fmt.Fprintf(bw, "\t%d -> %d [dir=none];\n", l[0], l[1])
+	}
+	for _, l := range oneWay {
+		fmt.Fprintf(bw, "\t%d -> %d;\n", l[0], l[1])
+	}
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+type geoJSONGeometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+type geoJSONFeature struct {
+	Type       string          `json:"type"`
+	Geometry   geoJSONGeometry `json:"geometry"`
+	Properties map[string]any  `json:"properties"`
+}
+
+// WriteGeoJSON writes g as a GeoJSON feature collection of a Point for
+// every node and a LineString for every connection, in world units with
+// the origin added to every position. Pass the record's Origin to place
+// exterior grids in the world.
+func (g *Graph) WriteGeoJSON(w io.Writer, originX, originY int32) error {
+	position := func(i int) [3]int32 {
+		n := g.Nodes[i]
+		return [3]int32{originX + n.X, originY + n.Y, n.Z}
+	}
+	features := []geoJSONFeature{}
+	for i, n := range g.Nodes {
+		features = append(features, geoJSONFeature{
+			Type:       "Feature",
+			Geometry:   geoJSONGeometry{Type: "Point", Coordinates: position(i)},
+			Properties: map[string]any{"index": i, "autogenerated": n.AutoGenerated != 0},
+		})
+	}
+	both, oneWay := g.links()
+	for _, links := range []struct {
+		links    [][2]int
+		directed bool
+	}{{both, false}, {oneWay, true}} {
+		for _, l := range links.links {
+			features = append(features, geoJSONFeature{
+				Type:       "Feature",
+				Geometry:   geoJSONGeometry{Type: "LineString", Coordinates: [][3]int32{position(l[0]), position(l[1])}},
+				Properties: map[string]any{"from": l[0], "to": l[1], "directed": links.directed},
+			})
+		}
+	}
+	return json.NewEncoder(w).Encode(map[string]any{"type": "FeatureCollection", "features": features})
+}
@@ -0,0 +1,63 @@
+// PGRD records contain path grids, the graphs of points NPCs navigate
+// cells by.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package pathgrid
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// PGRD handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/PGRD
+const PGRD esm.RecordTag = "PGRD"
+
+// cellSize is the width of an exterior cell in world units.
+const cellSize = 8192
+
+func init() {
+	esm.RegisterRecord(PGRD, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		p, err := ParsePathGrid(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	// Like cells, exterior path grids are identified by their grid and
+	// interior ones, whose grid is zero, by the cell name. Only the grid
+	// is read, so path grids with a short DATA still have an identity.
+	esm.RegisterIdentity(PGRD, func(rec *esm.Record) (string, error) {
+		data := rec.Find(DATA)
+		if data == nil {
+			return "", fmt.Errorf("DATA: %w", esm.ErrSubrecordNotFound)
+		}
+		if len(data.Data) < 8 {
+			return "", fmt.Errorf("PGRD.DATA must be at least 8 bytes, got %d", len(data.Data))
+		}
+		x := int32(binary.LittleEndian.Uint32(data.Data[0:4]))
+		y := int32(binary.LittleEndian.Uint32(data.Data[4:8]))
+		if x != 0 || y != 0 {
+			return esm.GridKey(x, y), nil
+		}
+		return esm.NameIdentity(rec)
+	})
+	esm.RegisterSubrecords(PGRD,
+		&DATAField{},
+		&NAMEField{},
+		&PGRPField{},
+		&PGRCField{},
+	)
+}
+
+// Origin returns the world position of the corner of the path grid's
+// cell, which exterior points are relative to. It is 0,0 for interiors,
+// and for path grids without DATA.
+func (r *PathGridRecord) Origin() (x, y int32) {
+	if r.DATA == nil {
+		return 0, 0
+	}
+	return r.DATA.GridX * cellSize, r.DATA.GridY * cellSize
+}
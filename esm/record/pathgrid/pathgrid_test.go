@@ -0,0 +1,188 @@
+package pathgrid
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+// square is a path grid of four points connected in a ring, with junk in
+// the unknown bytes like the editor leaves.
+func square() *PathGridRecord {
+	return &PathGridRecord{
+		DATA: &DATAField{GridX: -2, GridY: 3, Granularity: 1024, PointCount: 4},
+		NAME: &NAMEField{Value: ""},
+		PGRP: &PGRPField{Points: []Point{
+			{X: 0, Y: 0, Z: 10, AutoGenerated: 1, Connections: 2, Unknown: 0xbeef},
+			{X: 100, Y: 0, Z: 20, Connections: 2},
+			{X: 100, Y: 100, Z: 30, Connections: 2},
+			{X: 0, Y: 100, Z: 40, Connections: 2, Unknown: 7},
+		}},
+		PGRC: &PGRCField{Targets: []uint32{1, 3, 0, 2, 1, 3, 2, 0}},
+	}
+}
+
+func TestGraph(t *testing.T) {
+	rec, err := esm.Encode(square())
+	require.NoError(t, err)
+	r, err := ParsePathGrid(rec)
+	require.NoError(t, err)
+
+	g, err := r.Graph()
+	require.NoError(t, err)
+	require.Len(t, g.Nodes, 4)
+	require.Equal(t, Node{X: 0, Y: 0, Z: 10, AutoGenerated: 1, Unknown: 0xbeef}, g.Nodes[0])
+	require.Equal(t, [][]int{{1, 3}, {0, 2}, {1, 3}, {2, 0}}, g.Edges)
+
+	x, y := r.Origin()
+	require.Equal(t, int32(-2*8192), x)
+	require.Equal(t, int32(3*8192), y)
+
+	// An unedited graph encodes to the same bytes.
+	require.NoError(t, r.SetGraph(g))
+	again, err := esm.Encode(r)
+	require.NoError(t, err)
+	require.Equal(t, rec.Subrecords, again.Subrecords)
+
+	empty := &PathGridRecord{DATA: &DATAField{}}
+	g, err = empty.Graph()
+	require.NoError(t, err)
+	require.Empty(t, g.Nodes)
+}
+
+func TestMissingDATA(t *testing.T) {
+	rec, err := esm.Encode(square())
+	require.NoError(t, err)
+	rec.Subrecords = rec.Subrecords[1:]
+	r, err := ParsePathGrid(rec, esm.WithLenient())
+	require.NoError(t, err)
+	require.Nil(t, r.DATA)
+
+	_, err = r.Graph()
+	require.ErrorIs(t, err, esm.ErrSubrecordNotFound)
+	x, y := r.Origin()
+	require.Zero(t, x)
+	require.Zero(t, y)
+
+	require.NoError(t, r.SetGraph(&Graph{Nodes: []Node{{}, {}}, Edges: [][]int{{1}, {0}}}))
+	require.Equal(t, &DATAField{PointCount: 2}, r.DATA)
+	g, err := r.Graph()
+	require.NoError(t, err)
+	require.Len(t, g.Nodes, 2)
+}
+
+func TestGraphInvalid(t *testing.T) {
+	r := square()
+	r.DATA.PointCount = 5
+	_, err := r.Graph()
+	require.ErrorContains(t, err, "DATA says 5")
+
+	r = square()
+	r.PGRC.Targets = r.PGRC.Targets[:7]
+	_, err = r.Graph()
+	require.ErrorContains(t, err, "PGRP says 8")
+
+	r = square()
+	r.PGRC.Targets[5] = 4
+	_, err = r.Graph()
+	require.ErrorContains(t, err, "point 2 connects to point 4")
+
+	require.Error(t, (&PGRPField{}).Unmarshal(&esm.Subrecord{Tag: PGRP, Data: make([]byte, 15)}))
+	require.Error(t, (&PGRCField{}).Unmarshal(&esm.Subrecord{Tag: PGRC, Data: make([]byte, 3)}))
+
+	r = square()
+	require.Error(t, r.SetGraph(&Graph{Nodes: []Node{{}}, Edges: [][]int{{1}}}))
+	require.Error(t, r.SetGraph(&Graph{Nodes: []Node{{}}}))
+	require.Error(t, r.SetGraph(&Graph{Nodes: make([]Node, 2), Edges: [][]int{make([]int, 256), {}}}))
+	require.Equal(t, uint16(4), r.DATA.PointCount)
+}
+
+func TestGraphEdit(t *testing.T) {
+	r := square()
+	g, err := r.Graph()
+	require.NoError(t, err)
+
+	center := g.AddNode(Node{X: 50, Y: 50})
+	require.Equal(t, 4, center)
+	require.NoError(t, g.Connect(center, 0))
+	require.NoError(t, g.Connect(0, center))
+	require.NoError(t, g.Connect(center, 2))
+	require.Equal(t, []int{1, 3, 4}, g.Edges[0])
+	require.Equal(t, []int{0, 2}, g.Edges[center])
+	require.Error(t, g.Connect(center, center))
+	require.Error(t, g.Connect(0, 5))
+
+	require.NoError(t, g.Disconnect(0, 1))
+	require.Equal(t, []int{3, 4}, g.Edges[0])
+	require.Equal(t, []int{2}, g.Edges[1])
+
+	require.NoError(t, g.RemoveNode(1))
+	require.Equal(t, [][]int{{2, 3}, {2, 3}, {1, 0}, {0, 1}}, g.Edges)
+	require.Equal(t, int32(50), g.Nodes[3].X)
+	require.Error(t, g.RemoveNode(4))
+
+	require.NoError(t, r.SetGraph(g))
+	require.Equal(t, uint16(4), r.DATA.PointCount)
+	require.Equal(t, []uint32{2, 3, 2, 3, 1, 0, 0, 1}, r.PGRC.Targets)
+	require.Equal(t, uint8(2), r.PGRP.Points[0].Connections)
+	require.Equal(t, uint16(0xbeef), r.PGRP.Points[0].Unknown)
+
+	rec, err := esm.Encode(r)
+	require.NoError(t, err)
+	parsed, err := ParsePathGrid(rec)
+	require.NoError(t, err)
+	decoded, err := parsed.Graph()
+	require.NoError(t, err)
+	require.Equal(t, g, decoded)
+}
+
+func TestWriteDOT(t *testing.T) {
+	g := &Graph{
+		Nodes: []Node{{X: 1, Y: 2, Z: 3}, {X: 4, Y: 5, Z: 6}, {}},
+		Edges: [][]int{{1}, {0, 2}, {}},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, g.WriteDOT(&buf, "Vivec, Arena"))
+	require.Equal(t, `digraph "Vivec, Arena" {
+	node [shape=point];
+	0 [pos="1,2", z=3];
+	1 [pos="4,5", z=6];
+	2 [pos="0,0", z=0];
+	0 -> 1 [dir=none];
+	1 -> 2;
+}
+`, buf.String())
+}
+
+func TestWriteGeoJSON(t *testing.T) {
+	r := square()
+	g, err := r.Graph()
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	x, y := r.Origin()
+	require.NoError(t, g.WriteGeoJSON(&buf, x, y))
+
+	var out struct {
+		Type     string
+		Features []struct {
+			Geometry struct {
+				Type        string
+				Coordinates json.RawMessage
+			}
+			Properties map[string]any
+		}
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+	require.Equal(t, "FeatureCollection", out.Type)
+	// Four points, and four edges each stored both ways.
+	require.Len(t, out.Features, 8)
+	require.Equal(t, "Point", out.Features[0].Geometry.Type)
+	require.JSONEq(t, `[-16384,24576,10]`, string(out.Features[0].Geometry.Coordinates))
+	require.Equal(t, true, out.Features[0].Properties["autogenerated"])
+	require.Equal(t, "LineString", out.Features[4].Geometry.Type)
+	require.JSONEq(t, `[[-16384,24576,10],[-16284,24576,20]]`, string(out.Features[4].Geometry.Coordinates))
+	require.Equal(t, false, out.Features[4].Properties["directed"])
+}
@@ -0,0 +1,103 @@
+package pathgrid
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// PGRP lists the points of a path grid.
+const PGRP esm.SubrecordTag = "PGRP"
+
+// PGRC lists the connections of every point, in point order.
+const PGRC esm.SubrecordTag = "PGRC"
+
+// pointSize is the size in bytes of a point in PGRP.
+const pointSize = 16
+
+// Point is a point of a path grid. Coordinates of exterior points are
+// relative to the cell's corner.
+type Point struct {
+	X, Y, Z       int32
+	AutoGenerated uint8
+	// Connections is the number of entries of PGRC that belong to the
+	// point.
+	Connections uint8
+	Unknown     uint16
+}
+
+// PGRPField holds the points of a path grid.
+type PGRPField struct {
+	Points []Point
+}
+
+func (s *PGRPField) Tag() esm.SubrecordTag { return PGRP }
+
+// Layout implements esm.Structured.
+func (s *PGRPField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Points", Type: "x", Offset: 0, Size: -1},
+	}
+}
+
+func (s *PGRPField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data)%pointSize != 0 {
+		return fmt.Errorf("PGRP size %d is not a multiple of %d", len(sub.Data), pointSize)
+	}
+	s.Points = make([]Point, len(sub.Data)/pointSize)
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, s.Points)
+	return err
+}
+
+func (s *PGRPField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := binary.Append(make([]byte, 0, len(s.Points)*pointSize), binary.LittleEndian, s.Points)
+	if err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
+
+// PGRCField holds the connections of a path grid: the index of the point
+// each connection leads to, grouped by the point it starts from.
+type PGRCField struct {
+	Targets []uint32
+}
+
+func (s *PGRCField) Tag() esm.SubrecordTag { return PGRC }
+
+// Layout implements esm.Structured.
+func (s *PGRCField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Targets", Type: "x", Offset: 0, Size: -1},
+	}
+}
+
+func (s *PGRCField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data)%4 != 0 {
+		return fmt.Errorf("PGRC size %d is not a multiple of 4", len(sub.Data))
+	}
+	s.Targets = make([]uint32, len(sub.Data)/4)
+	_, err := binary.Decode(sub.Data, binary.LittleEndian, s.Targets)
+	return err
+}
+
+func (s *PGRCField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data, err := binary.Append(make([]byte, 0, len(s.Targets)*4), binary.LittleEndian, s.Targets)
+	if err != nil {
+		return nil, err
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
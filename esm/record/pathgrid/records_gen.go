@@ -0,0 +1,112 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package pathgrid
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// PathGridRecord is the graph of points NPCs navigate a cell by.
+type PathGridRecord struct {
+	DATA *DATAField
+	NAME *NAMEField
+	PGRP *PGRPField
+	PGRC *PGRCField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// pathGridRecordFields lists the tag that starts each field of PathGridRecord.
+var pathGridRecordFields = []esm.SubrecordTag{DATA, NAME, PGRP, PGRC}
+
+func (r *PathGridRecord) Tag() esm.RecordTag { return PGRD }
+
+func (r *PathGridRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.DATA); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.PGRP); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.PGRC); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParsePathGrid builds a PathGridRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParsePathGrid(rec *esm.Record, opts ...esm.ParseOption) (*PathGridRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != PGRD {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parsePathGridRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parsePathGridRecord parses the PathGridRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parsePathGridRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*PathGridRecord, int, error) {
+	r := &PathGridRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(pathGridRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.DATA != nil {
+				f.Keep(i)
+				break
+			}
+			r.DATA, err = esm.ParseField[DATAField](f, i)
+		case 1:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 2:
+			if r.PGRP != nil {
+				f.Keep(i)
+				break
+			}
+			r.PGRP, err = esm.ParseField[PGRPField](f, i)
+		case 3:
+			if r.PGRC != nil {
+				f.Keep(i)
+				break
+			}
+			r.PGRC, err = esm.ParseField[PGRCField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.DATA == nil {
+		if err := f.Missing(DATA); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
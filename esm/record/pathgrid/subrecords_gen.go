@@ -0,0 +1,109 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package pathgrid
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Name of the cell the path grid belongs to.
+const NAME esm.SubrecordTag = "NAME"
+
+// Name of the cell the path grid belongs to.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Path grid data.
+const DATA esm.SubrecordTag = "DATA"
+
+// Path grid data.
+type DATAField struct {
+	// Grid of an exterior cell, or 0 for interiors.
+	GridX int32
+	GridY int32
+	// Spacing the editor generated points with.
+	Granularity uint16
+	// Number of points in PGRP.
+	PointCount uint16
+}
+
+func (t *DATAField) Tag() esm.SubrecordTag { return DATA }
+
+// Size implements esm.Sized.
+func (t *DATAField) Size() int { return 12 }
+
+// Layout implements esm.Structured.
+func (t *DATAField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "GridX", Type: "i32", Offset: 0, Size: 4},
+		{Name: "GridY", Type: "i32", Offset: 4, Size: 4},
+		{Name: "Granularity", Type: "u16", Offset: 8, Size: 2},
+		{Name: "PointCount", Type: "u16", Offset: 10, Size: 2},
+	}
+}
+
+func (s *DATAField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 12 {
+		return fmt.Errorf("DATA must be 12 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.GridX = int32(binary.LittleEndian.Uint32(d[0:4]))
+	s.GridY = int32(binary.LittleEndian.Uint32(d[4:8]))
+	s.Granularity = binary.LittleEndian.Uint16(d[8:10])
+	s.PointCount = binary.LittleEndian.Uint16(d[10:12])
+	return nil
+}
+
+func (s *DATAField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 12)
+	binary.LittleEndian.PutUint32(d[0:4], uint32(s.GridX))
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.GridY))
+	binary.LittleEndian.PutUint16(d[8:10], uint16(s.Granularity))
+	binary.LittleEndian.PutUint16(d[10:12], uint16(s.PointCount))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
@@ -0,0 +1,29 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package pathgrid
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("DATA", func(t *testing.T) {
+		for range 32 {
+			s := &DATAField{}
+			s.GridX = int32(r.Uint64())
+			s.GridY = int32(r.Uint64())
+			s.Granularity = uint16(r.Uint64())
+			s.PointCount = uint16(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+}
@@ -0,0 +1,28 @@
+// PROB records contain probes.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package prob
+
+import "github.com/ernmw/omwpacker/esm"
+
+// PROB handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/PROB
+const PROB esm.RecordTag = "PROB"
+
+func init() {
+	esm.RegisterRecord(PROB, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		p, err := ParseProbe(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	})
+	esm.RegisterSubrecords(PROB,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&PBDTField{},
+		&SCRIField{},
+		&ITEXField{},
+	)
+}
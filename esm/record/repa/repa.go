@@ -0,0 +1,28 @@
+// REPA records contain repair items.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package repa
+
+import "github.com/ernmw/omwpacker/esm"
+
+// REPA handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/REPA
+const REPA esm.RecordTag = "REPA"
+
+func init() {
+	esm.RegisterRecord(REPA, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		r, err := ParseRepair(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	})
+	esm.RegisterSubrecords(REPA,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&RIDTField{},
+		&SCRIField{},
+		&ITEXField{},
+	)
+}
@@ -0,0 +1,96 @@
+package script
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// SourceExt is the extension of the files ExtractSources writes.
+const SourceExt = ".mwscript"
+
+// LoadScripts returns the scripts of plugins, which are in load order.
+// A script overridden by a later plugin is returned once, as the last
+// plugin has it, in the position it first appeared. Deleted scripts are
+// left out. A script without a header, which lenient parsing allows, is an
+// error, since it can't be matched to the scripts it overrides.
+func LoadScripts(plugins []*esm.Plugin, opts ...esm.ParseOption) ([]*ScriptRecord, error) {
+	scripts := []*ScriptRecord{}
+	index := map[string]int{}
+	for _, p := range plugins {
+		for _, rec := range p.Records {
+			if rec.Tag != SCPT {
+				continue
+			}
+			s, err := ParseScript(rec, opts...)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", p.Path, err)
+			}
+			if s.SCHD == nil {
+				return nil, fmt.Errorf("%s: script without SCHD: %w", p.Path, esm.ErrSubrecordNotFound)
+			}
+			key := esm.FoldID(s.ID())
+			if i, ok := index[key]; ok {
+				scripts[i] = s
+			} else {
+				index[key] = len(scripts)
+				scripts = append(scripts, s)
+			}
+		}
+	}
+	return slices.DeleteFunc(scripts, func(s *ScriptRecord) bool {
+		return s.Deleted()
+	}), nil
+}
+
+// Deleted reports whether r deletes the script with its ID.
+func (r *ScriptRecord) Deleted() bool {
+	return esm.Deleted(r.Unknown)
+}
+
+// ExtractSources writes the source of each script to dir, creating it if
+// needed, in a file named by the script ID with SourceExt. Characters that
+// can't appear in file names are replaced by underscores. Scripts without
+// source are skipped. It returns the paths it wrote.
+func ExtractSources(dir string, scripts []*ScriptRecord) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	paths := []string{}
+	seen := map[string]string{}
+	for _, s := range scripts {
+		if s.SCTX == nil {
+			continue
+		}
+		name := SourceFileName(s.ID())
+		if other, ok := seen[strings.ToLower(name)]; ok {
+			return paths, fmt.Errorf("scripts %q and %q both extract to %q", other, s.ID(), name)
+		}
+		seen[strings.ToLower(name)] = s.ID()
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(s.Source()), 0o644); err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
+
+// SourceFileName returns the name ExtractSources gives the source of the
+// script id.
+func SourceFileName(id string) string {
+	name := strings.Map(func(r rune) rune {
+		if r < ' ' || strings.ContainsRune(`<>:"/\|?*`, r) {
+			return '_'
+		}
+		return r
+	}, id)
+	if name == "" || strings.Trim(name, ". ") == "" {
+		name = "_" + name
+	}
+	return name + SourceExt
+}
@@ -0,0 +1,112 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package script
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// ScriptRecord is an mwscript script.
+type ScriptRecord struct {
+	SCHD *SCHDField
+	SCVR *SCVRField
+	SCDT *SCDTField
+	SCTX *SCTXField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// scriptRecordFields lists the tag that starts each field of ScriptRecord.
+var scriptRecordFields = []esm.SubrecordTag{SCHD, SCVR, SCDT, SCTX}
+
+func (r *ScriptRecord) Tag() esm.RecordTag { return SCPT }
+
+func (r *ScriptRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.SCHD); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCVR); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCTX); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseScript builds a ScriptRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseScript(rec *esm.Record, opts ...esm.ParseOption) (*ScriptRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != SCPT {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseScriptRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseScriptRecord parses the ScriptRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseScriptRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*ScriptRecord, int, error) {
+	r := &ScriptRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(scriptRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.SCHD != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCHD, err = esm.ParseField[SCHDField](f, i)
+		case 1:
+			if r.SCVR != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCVR, err = esm.ParseField[SCVRField](f, i)
+		case 2:
+			if r.SCDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCDT, err = esm.ParseField[SCDTField](f, i)
+		case 3:
+			if r.SCTX != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCTX, err = esm.ParseField[SCTXField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.SCHD == nil {
+		if err := f.Missing(SCHD); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
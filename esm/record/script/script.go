@@ -0,0 +1,83 @@
+// SCPT records contain mwscript scripts.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package script
+
+import (
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// SCPT handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/SCPT
+const SCPT esm.RecordTag = "SCPT"
+
+func init() {
+	esm.RegisterRecord(SCPT, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		s, err := ParseScript(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	})
+	// Scripts have no NAME; their ID is in the header.
+	esm.RegisterIdentity(SCPT, func(rec *esm.Record) (string, error) {
+		schd := &SCHDField{}
+		if err := rec.UnmarshalFirst(schd); err != nil {
+			return "", err
+		}
+		return schd.Name, nil
+	})
+	esm.RegisterSubrecords(SCPT,
+		&SCHDField{},
+		&SCVRField{},
+		&SCDTField{},
+		&SCTXField{},
+	)
+}
+
+// Variables are the local variables of a script, by type.
+type Variables struct {
+	Shorts []string
+	Longs  []string
+	Floats []string
+}
+
+// ID returns the script's ID, or "" if it has no header.
+func (r *ScriptRecord) ID() string {
+	if r.SCHD == nil {
+		return ""
+	}
+	return r.SCHD.Name
+}
+
+// Source returns the script's source, or "" if it has none.
+func (r *ScriptRecord) Source() string {
+	if r.SCTX == nil {
+		return ""
+	}
+	return r.SCTX.Value
+}
+
+// Variables splits SCVR into the variables of each type, using the counts
+// in SCHD. It fails if the counts don't add up to the number of names.
+func (r *ScriptRecord) Variables() (*Variables, error) {
+	if r.SCHD == nil {
+		return nil, fmt.Errorf("SCHD: %w", esm.ErrSubrecordNotFound)
+	}
+	names := []string{}
+	if r.SCVR != nil {
+		names = r.SCVR.Names
+	}
+	shorts, longs, floats := int(r.SCHD.NumShorts), int(r.SCHD.NumLongs), int(r.SCHD.NumFloats)
+	if shorts+longs+floats != len(names) {
+		return nil, fmt.Errorf("script %q declares %d shorts, %d longs and %d floats but names %d variables",
+			r.ID(), shorts, longs, floats, len(names))
+	}
+	return &Variables{
+		Shorts: names[:shorts],
+		Longs:  names[shorts : shorts+longs],
+		Floats: names[shorts+longs:],
+	}, nil
+}
@@ -0,0 +1,96 @@
+package script
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/stretchr/testify/require"
+)
+
+func script(t *testing.T, id, source string, vars ...string) *esm.Record {
+	rec, err := esm.Encode(&ScriptRecord{
+		SCHD: &SCHDField{Name: id, NumShorts: 1, NumFloats: uint32(len(vars) - 1)},
+		SCVR: &SCVRField{Names: vars},
+		SCDT: &SCDTField{Value: []byte{0x24, 0x01, 0x00, 0x00}},
+		SCTX: &SCTXField{Value: source},
+	})
+	require.NoError(t, err)
+	return rec
+}
+
+func TestSCVR(t *testing.T) {
+	raw := []byte("done\x00timer\x00")
+	var s SCVRField
+	require.NoError(t, (&esm.Subrecord{Tag: SCVR, Data: raw}).UnmarshalTo(&s))
+	require.Equal(t, []string{"done", "timer"}, s.Names)
+	sub, err := s.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, raw, sub.Data)
+
+	require.NoError(t, (&esm.Subrecord{Tag: SCVR}).UnmarshalTo(&s))
+	require.Empty(t, s.Names)
+	require.Error(t, (&esm.Subrecord{Tag: SCVR, Data: []byte("done")}).UnmarshalTo(&s))
+	_, err = (&SCVRField{Names: []string{"a\x00b"}}).Marshal()
+	require.Error(t, err)
+}
+
+func TestVariables(t *testing.T) {
+	s, err := ParseScript(script(t, "doorScript", "begin doorScript\r\nend", "done", "timer", "speed"))
+	require.NoError(t, err)
+	s.SCHD.NumLongs, s.SCHD.NumFloats = 1, 1
+	vars, err := s.Variables()
+	require.NoError(t, err)
+	require.Equal(t, &Variables{Shorts: []string{"done"}, Longs: []string{"timer"}, Floats: []string{"speed"}}, vars)
+
+	s.SCHD.NumFloats = 2
+	_, err = s.Variables()
+	require.Error(t, err)
+}
+
+func TestExtractSources(t *testing.T) {
+	deleted := script(t, "gone", "begin gone\r\nend", "x")
+	deleted.Subrecords = append(deleted.Subrecords, &esm.Subrecord{Tag: esm.DELE, Data: []byte{0, 0, 0, 0}})
+	plugins := []*esm.Plugin{
+		{Path: "Morrowind.esm", Records: []*esm.Record{
+			script(t, "doorScript", "begin doorScript\r\nend", "x"),
+			script(t, "gone", "begin gone\r\nend", "x"),
+			script(t, "a/b", "begin a/b\r\nend", "x"),
+		}},
+		{Path: "patch.esp", Records: []*esm.Record{
+			script(t, "DOORSCRIPT", "begin DOORSCRIPT\r\nshort y\r\nend", "x"),
+			deleted,
+		}},
+	}
+	scripts, err := LoadScripts(plugins)
+	require.NoError(t, err)
+	require.Len(t, scripts, 2)
+	require.Equal(t, "DOORSCRIPT", scripts[0].ID())
+
+	dir := t.TempDir()
+	paths, err := ExtractSources(dir, scripts)
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, "DOORSCRIPT.mwscript"), filepath.Join(dir, "a_b.mwscript")}, paths)
+	source, err := os.ReadFile(paths[0])
+	require.NoError(t, err)
+	require.Equal(t, "begin DOORSCRIPT\r\nshort y\r\nend", string(source))
+
+	clash, err := ParseScript(script(t, "a?b", "", "x"))
+	require.NoError(t, err)
+	_, err = ExtractSources(dir, append(scripts, clash))
+	require.Error(t, err)
+}
+
+func TestMissingHeader(t *testing.T) {
+	rec := &esm.Record{Tag: SCPT, Subrecords: []*esm.Subrecord{{Tag: SCTX, Data: []byte("begin x\r\nend")}}}
+	s, err := ParseScript(rec, esm.WithLenient())
+	require.NoError(t, err)
+	require.Nil(t, s.SCHD)
+	require.Empty(t, s.ID())
+	_, err = s.Variables()
+	require.ErrorIs(t, err, esm.ErrSubrecordNotFound)
+
+	_, err = LoadScripts([]*esm.Plugin{{Path: "broken.esp", Records: []*esm.Record{rec}}}, esm.WithLenient())
+	require.ErrorIs(t, err, esm.ErrSubrecordNotFound)
+}
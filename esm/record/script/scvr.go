@@ -0,0 +1,64 @@
+package script
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// SCVR lists the names of the script's local variables.
+const SCVR esm.SubrecordTag = "SCVR"
+
+// SCVRField holds the names of the script's local variables: the shorts,
+// then the longs, then the floats, as counted in SCHD. Each name is
+// stored null-terminated.
+type SCVRField struct {
+	Names []string
+}
+
+func (s *SCVRField) Tag() esm.SubrecordTag { return SCVR }
+
+// Layout implements esm.Structured.
+func (s *SCVRField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Names", Type: "x", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCVRField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	s.Names = []string{}
+	if len(sub.Data) == 0 {
+		return nil
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return errors.New("SCVR not null-terminated")
+	}
+	for _, name := range bytes.Split(sub.Data[:len(sub.Data)-1], []byte{0}) {
+		s.Names = append(s.Names, util.DecodeString(name))
+	}
+	return nil
+}
+
+func (s *SCVRField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	data := []byte{}
+	for _, name := range s.Names {
+		raw, err := util.EncodeString(name)
+		if err != nil {
+			return nil, fmt.Errorf("encode SCVR: %w", err)
+		}
+		if bytes.IndexByte(raw, 0) >= 0 {
+			return nil, fmt.Errorf("SCVR name %q contains a null", name)
+		}
+		data = append(append(data, raw...), 0)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: data}, nil
+}
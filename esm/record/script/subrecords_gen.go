@@ -0,0 +1,148 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package script
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Compiled script.
+const SCDT esm.SubrecordTag = "SCDT"
+
+// Compiled script.
+type SCDTField struct{ Value []byte }
+
+func (t *SCDTField) Tag() esm.SubrecordTag { return SCDT }
+
+// Layout implements esm.Structured.
+func (t *SCDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "x", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	s.Value = bytes.Clone(sub.Data)
+	return nil
+}
+
+func (s *SCDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: bytes.Clone(s.Value)}, nil
+}
+
+// Script source.
+const SCTX esm.SubrecordTag = "SCTX"
+
+// Script source.
+type SCTXField struct{ Value string }
+
+func (t *SCTXField) Tag() esm.SubrecordTag { return SCTX }
+
+// Layout implements esm.Structured.
+func (t *SCTXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCTXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	s.Value = util.DecodeString(sub.Data)
+
+	return nil
+}
+
+func (s *SCTXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCTX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: raw}, nil
+}
+
+// Script header.
+const SCHD esm.SubrecordTag = "SCHD"
+
+// Script header.
+type SCHDField struct {
+	// Script ID.
+	Name      string
+	NumShorts uint32
+	NumLongs  uint32
+	NumFloats uint32
+	// Size of SCDT in bytes.
+	DataSize uint32
+	// Size of SCVR in bytes.
+	VariablesSize uint32
+	// Junk holds the raw bytes of fixed-length strings that had data after
+	// their terminator, by field name, so they survive a round trip.
+	Junk map[string][]byte
+}
+
+func (t *SCHDField) Tag() esm.SubrecordTag { return SCHD }
+
+// Size implements esm.Sized.
+func (t *SCHDField) Size() int { return 52 }
+
+// Layout implements esm.Structured.
+func (t *SCHDField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Name", Type: "z", Offset: 0, Size: 32},
+		{Name: "NumShorts", Type: "u32", Offset: 32, Size: 4},
+		{Name: "NumLongs", Type: "u32", Offset: 36, Size: 4},
+		{Name: "NumFloats", Type: "u32", Offset: 40, Size: 4},
+		{Name: "DataSize", Type: "u32", Offset: 44, Size: 4},
+		{Name: "VariablesSize", Type: "u32", Offset: 48, Size: 4},
+	}
+}
+
+func (s *SCHDField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 52 {
+		return fmt.Errorf("SCHD must be 52 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Junk = nil
+	s.Name = util.ReadFixedString(d[0:32], &s.Junk, "Name")
+	s.NumShorts = binary.LittleEndian.Uint32(d[32:36])
+	s.NumLongs = binary.LittleEndian.Uint32(d[36:40])
+	s.NumFloats = binary.LittleEndian.Uint32(d[40:44])
+	s.DataSize = binary.LittleEndian.Uint32(d[44:48])
+	s.VariablesSize = binary.LittleEndian.Uint32(d[48:52])
+	return nil
+}
+
+func (s *SCHDField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 52)
+	if err := util.PutFixedString(d[0:32], s.Name, s.Junk, "Name"); err != nil {
+		return nil, fmt.Errorf("SCHD.Name: %w", err)
+	}
+	binary.LittleEndian.PutUint32(d[32:36], uint32(s.NumShorts))
+	binary.LittleEndian.PutUint32(d[36:40], uint32(s.NumLongs))
+	binary.LittleEndian.PutUint32(d[40:44], uint32(s.NumFloats))
+	binary.LittleEndian.PutUint32(d[44:48], uint32(s.DataSize))
+	binary.LittleEndian.PutUint32(d[48:52], uint32(s.VariablesSize))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
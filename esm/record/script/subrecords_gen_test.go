@@ -0,0 +1,36 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package script
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("SCDT", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCDTField{Value: []byte(gentest.String(r, 64))})
+		}
+	})
+	t.Run("SCHD", func(t *testing.T) {
+		for range 32 {
+			s := &SCHDField{}
+			s.Name = gentest.String(r, 32)
+			s.NumShorts = uint32(r.Uint64())
+			s.NumLongs = uint32(r.Uint64())
+			s.NumFloats = uint32(r.Uint64())
+			s.DataSize = uint32(r.Uint64())
+			s.VariablesSize = uint32(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+	t.Run("SCTX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCTXField{Value: gentest.String(r, 64)})
+		}
+	})
+}
@@ -0,0 +1,116 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package spel
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+)
+
+// SpellRecord is a spell, or an ability, disease, curse or power.
+type SpellRecord struct {
+	NAME    *NAMEField
+	FNAM    *FNAMField
+	SPDT    *SPDTField
+	Effects []*magic.ENAMField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// spellRecordFields lists the tag that starts each field of SpellRecord.
+var spellRecordFields = []esm.SubrecordTag{NAME, FNAM, SPDT, magic.ENAM}
+
+func (r *SpellRecord) Tag() esm.RecordTag { return SPEL }
+
+func (r *SpellRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SPDT); err != nil {
+		return nil, err
+	}
+	for _, f := range r.Effects {
+		if out, err = esm.AppendMarshalled(out, f); err != nil {
+			return nil, err
+		}
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseSpell builds a SpellRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseSpell(rec *esm.Record, opts ...esm.ParseOption) (*SpellRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != SPEL {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseSpellRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseSpellRecord parses the SpellRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseSpellRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*SpellRecord, int, error) {
+	r := &SpellRecord{
+		Effects: []*magic.ENAMField{},
+	}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(spellRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 2:
+			if r.SPDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.SPDT, err = esm.ParseField[SPDTField](f, i)
+		case 3:
+			var v *magic.ENAMField
+			if v, err = esm.ParseField[magic.ENAMField](f, i); v != nil {
+				r.Effects = append(r.Effects, v)
+			}
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
@@ -0,0 +1,29 @@
+// SPEL records contain spells, abilities, diseases, curses and powers.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package spel
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/magic"
+)
+
+// SPEL handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/SPEL
+const SPEL esm.RecordTag = "SPEL"
+
+func init() {
+	esm.RegisterRecord(SPEL, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		s, err := ParseSpell(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	})
+	esm.RegisterSubrecords(SPEL,
+		&NAMEField{},
+		&FNAMField{},
+		&SPDTField{},
+		&magic.ENAMField{},
+	)
+}
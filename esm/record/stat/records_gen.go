@@ -0,0 +1,92 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package stat
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// StaticRecord is an object that does nothing but show its model.
+type StaticRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// staticRecordFields lists the tag that starts each field of StaticRecord.
+var staticRecordFields = []esm.SubrecordTag{NAME, MODL}
+
+func (r *StaticRecord) Tag() esm.RecordTag { return STAT }
+
+func (r *StaticRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseStatic builds a StaticRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseStatic(rec *esm.Record, opts ...esm.ParseOption) (*StaticRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != STAT {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseStaticRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseStaticRecord parses the StaticRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseStaticRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*StaticRecord, int, error) {
+	r := &StaticRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(staticRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
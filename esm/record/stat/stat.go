@@ -0,0 +1,24 @@
+// STAT records contain static objects.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package stat
+
+import "github.com/ernmw/omwpacker/esm"
+
+// STAT handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/STAT
+const STAT esm.RecordTag = "STAT"
+
+func init() {
+	esm.RegisterRecord(STAT, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		s, err := ParseStatic(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return s, nil
+	})
+	esm.RegisterSubrecords(STAT,
+		&NAMEField{},
+		&MODLField{},
+	)
+}
@@ -11,7 +11,7 @@ import (
 
 type HEDRdata struct {
 	Version     float32
-	Flags       uint32
+	Flags       HeaderFlags
 	Name        string
 	Description string
 	NumRecords  uint32
@@ -21,16 +21,32 @@ func (h *HEDRdata) Tag() esm.SubrecordTag {
 	return HEDR
 }
 
+// Size implements esm.Sized.
+func (h *HEDRdata) Size() int {
+	return 300
+}
+
+// Layout implements esm.Structured.
+func (h *HEDRdata) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Version", Type: "f32", Offset: 0, Size: 4},
+		{Name: "Flags", Type: "u32", Offset: 4, Size: 4},
+		{Name: "Name", Type: "z", Offset: 8, Size: 32},
+		{Name: "Description", Type: "z", Offset: 40, Size: 256},
+		{Name: "NumRecords", Type: "u32", Offset: 296, Size: 4},
+	}
+}
+
 func (h *HEDRdata) Unmarshal(sub *esm.Subrecord) error {
 	if h == nil || sub == nil {
 		return esm.ErrArgumentNil
 	}
 	// require full HEDR payload size (300 bytes)
-	if len(sub.Data) < 300 {
-		return fmt.Errorf("%q subrecord too short: %d < 300", h.Tag(), len(sub.Data))
+	if len(sub.Data) != 300 {
+		return fmt.Errorf("%q subrecord must be 300 bytes, got %d", h.Tag(), len(sub.Data))
 	}
 	h.Version = util.BytesToFloat32(sub.Data[0:4])
-	h.Flags = binary.LittleEndian.Uint32(sub.Data[4:8])
+	h.Flags = HeaderFlags(binary.LittleEndian.Uint32(sub.Data[4:8]))
 	h.Name = util.ReadPaddedString(sub.Data[8 : 8+32])
 	h.Description = util.ReadPaddedString(sub.Data[8+32 : 8+32+256])
 	h.NumRecords = binary.LittleEndian.Uint32(sub.Data[8+32+256 : 8+32+256+4])
@@ -38,6 +54,9 @@ func (h *HEDRdata) Unmarshal(sub *esm.Subrecord) error {
 }
 
 func (h *HEDRdata) Marshal() (*esm.Subrecord, error) {
+	if h == nil {
+		return nil, nil
+	}
 	buff := new(bytes.Buffer)
 
 	if err := binary.Write(buff, binary.LittleEndian, h.Version); err != nil {
@@ -46,10 +65,18 @@ func (h *HEDRdata) Marshal() (*esm.Subrecord, error) {
 	if err := binary.Write(buff, binary.LittleEndian, h.Flags); err != nil {
 		return nil, err
 	}
-	if err := util.WritePaddedString(buff, []byte(h.Name), 32); err != nil {
+	name, err := util.EncodeString(h.Name)
+	if err != nil {
+		return nil, fmt.Errorf("encode name: %w", err)
+	}
+	if err := util.WritePaddedString(buff, name, 32); err != nil {
 		return nil, err
 	}
-	if err := util.WritePaddedString(buff, []byte(h.Description), 256); err != nil {
+	description, err := util.EncodeString(h.Description)
+	if err != nil {
+		return nil, fmt.Errorf("encode description: %w", err)
+	}
+	if err := util.WritePaddedString(buff, description, 256); err != nil {
 		return nil, err
 	}
 	if err := binary.Write(buff, binary.LittleEndian, h.NumRecords); err != nil {
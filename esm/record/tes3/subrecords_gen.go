@@ -0,0 +1,129 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package tes3
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// HeaderFlags are the flags of a HEDR subrecord.
+type HeaderFlags uint32
+
+const (
+	// Set for master files (.esm).
+	MasterFlag HeaderFlags = 0x01
+)
+
+// headerFlagsNames lists the named bits of HeaderFlags, in order.
+var headerFlagsNames = []struct {
+	flag HeaderFlags
+	name string
+}{
+	{MasterFlag, "Master"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f HeaderFlags) Has(flag HeaderFlags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *HeaderFlags) Set(flag HeaderFlags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f HeaderFlags) Names() []string {
+	names := []string{}
+	for _, n := range headerFlagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f HeaderFlags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseHeaderFlags combines bit names, as returned by Names, into a HeaderFlags.
+// Numbers are accepted too.
+func ParseHeaderFlags(names []string) (HeaderFlags, error) {
+	var f HeaderFlags
+next:
+	for _, name := range names {
+		for _, n := range headerFlagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown HeaderFlags %q", name)
+		}
+		f |= HeaderFlags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f HeaderFlags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *HeaderFlags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = HeaderFlags(v)
+		return nil
+	}
+	v, err := ParseHeaderFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f HeaderFlags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *HeaderFlags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = HeaderFlags(v)
+		return nil
+	}
+	v, err := ParseHeaderFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
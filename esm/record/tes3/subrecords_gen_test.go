@@ -0,0 +1,22 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package tes3
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("HeaderFlags", func(t *testing.T) {
+		for range 32 {
+			want := HeaderFlags(r.Uint64())
+			got, err := ParseHeaderFlags(want.Names())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+}
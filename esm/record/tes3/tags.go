@@ -11,3 +11,8 @@ const (
 	MAST esm.SubrecordTag = "MAST"
 	DATA esm.SubrecordTag = "DATA"
 )
+
+func init() {
+	esm.RegisterIdentity(TES3, esm.Singleton)
+	esm.RegisterSubrecords(TES3, &HEDRdata{})
+}
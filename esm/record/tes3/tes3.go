@@ -1,4 +1,6 @@
 // Package tes3 handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/TES3
+//
+//go:generate go run ../generator/gen.go subrecords.json
 package tes3
 
 import (
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/ernmw/omwpacker/esm/codepage"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,3 +25,20 @@ func TestHeader(t *testing.T) {
 	require.Equal(t, "description", h2.Description)
 	require.Equal(t, "name", h2.Name)
 }
+
+func TestHeaderEncoding(t *testing.T) {
+	defer codepage.Set(codepage.Win1252)
+
+	h := &HEDRdata{Version: 1.3, Name: "Привет"}
+	_, err := h.Marshal()
+	require.ErrorIs(t, err, codepage.ErrUnrepresentable)
+
+	codepage.Set(codepage.Win1251)
+	raw, err := h.Marshal()
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(raw.Data[8:], []byte{0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2, 0}))
+
+	h2 := &HEDRdata{}
+	require.NoError(t, raw.UnmarshalTo(h2))
+	require.Equal(t, "Привет", h2.Name)
+}
@@ -0,0 +1,142 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package weap
+
+import (
+	"github.com/ernmw/omwpacker/esm"
+)
+
+// WeaponRecord is a weapon, including arrows, bolts and thrown weapons.
+type WeaponRecord struct {
+	NAME *NAMEField
+	MODL *MODLField
+	FNAM *FNAMField
+	WPDT *WPDTField
+	ITEX *ITEXField
+	ENAM *ENAMField
+	SCRI *SCRIField
+	// Unknown holds the subrecords that weren't recognized, such as DELE.
+	Unknown []esm.UnknownSubrecord
+}
+
+// weaponRecordFields lists the tag that starts each field of WeaponRecord.
+var weaponRecordFields = []esm.SubrecordTag{NAME, MODL, FNAM, WPDT, ITEX, ENAM, SCRI}
+
+func (r *WeaponRecord) Tag() esm.RecordTag { return WEAP }
+
+func (r *WeaponRecord) OrderedRecords() ([]*esm.Subrecord, error) {
+	if r == nil {
+		return nil, nil
+	}
+	out := []*esm.Subrecord{}
+	var err error
+	if out, err = esm.AppendMarshalled(out, r.NAME); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.MODL); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.FNAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.WPDT); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ITEX); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.ENAM); err != nil {
+		return nil, err
+	}
+	if out, err = esm.AppendMarshalled(out, r.SCRI); err != nil {
+		return nil, err
+	}
+	return esm.InsertUnknown(out, r.Unknown), nil
+}
+
+// ParseWeapon builds a WeaponRecord from rec.
+// With lenient options, subrecords that can't be repaired are kept in
+// Unknown and reported instead of failing.
+func ParseWeapon(rec *esm.Record, opts ...esm.ParseOption) (*WeaponRecord, error) {
+	if rec == nil {
+		return nil, esm.ErrArgumentNil
+	}
+	if rec.Tag != WEAP {
+		return nil, esm.ErrTagMismatch
+	}
+	r, _, err := parseWeaponRecord(rec, 0, esm.NewParseOptions(opts...))
+	return r, err
+}
+
+// parseWeaponRecord parses the WeaponRecord starting at rec.Subrecords[start].
+// It reads to the end of the record.
+func parseWeaponRecord(rec *esm.Record, start int, o *esm.ParseOptions) (*WeaponRecord, int, error) {
+	r := &WeaponRecord{}
+	f := &esm.FieldParser{Rec: rec, Opts: o, Base: start, Unknown: &r.Unknown}
+	cursor := 0
+	i := start
+	for i < len(rec.Subrecords) {
+		tag := rec.Subrecords[i].Tag
+		field := esm.MatchField(weaponRecordFields, cursor, tag)
+		consumed := 1
+		var err error
+		switch field {
+		case 0:
+			if r.NAME != nil {
+				f.Keep(i)
+				break
+			}
+			r.NAME, err = esm.ParseField[NAMEField](f, i)
+		case 1:
+			if r.MODL != nil {
+				f.Keep(i)
+				break
+			}
+			r.MODL, err = esm.ParseField[MODLField](f, i)
+		case 2:
+			if r.FNAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.FNAM, err = esm.ParseField[FNAMField](f, i)
+		case 3:
+			if r.WPDT != nil {
+				f.Keep(i)
+				break
+			}
+			r.WPDT, err = esm.ParseField[WPDTField](f, i)
+		case 4:
+			if r.ITEX != nil {
+				f.Keep(i)
+				break
+			}
+			r.ITEX, err = esm.ParseField[ITEXField](f, i)
+		case 5:
+			if r.ENAM != nil {
+				f.Keep(i)
+				break
+			}
+			r.ENAM, err = esm.ParseField[ENAMField](f, i)
+		case 6:
+			if r.SCRI != nil {
+				f.Keep(i)
+				break
+			}
+			r.SCRI, err = esm.ParseField[SCRIField](f, i)
+		default:
+			f.Keep(i)
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if field >= 0 {
+			cursor = field
+		}
+		i += consumed
+	}
+	if r.NAME == nil {
+		if err := f.Missing(NAME); err != nil {
+			return nil, 0, err
+		}
+	}
+	return r, i - start, nil
+}
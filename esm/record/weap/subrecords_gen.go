@@ -0,0 +1,600 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package weap
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/internal/util"
+)
+
+// Enchantment ID.
+const ENAM esm.SubrecordTag = "ENAM"
+
+// Enchantment ID.
+type ENAMField struct{ Value string }
+
+func (t *ENAMField) Tag() esm.SubrecordTag { return ENAM }
+
+// Layout implements esm.Structured.
+func (t *ENAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ENAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ENAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ENAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Weapon data.
+const WPDT esm.SubrecordTag = "WPDT"
+
+// Weapon data.
+type WPDTField struct {
+	Weight        float32
+	Value         int32
+	Kind          Kind
+	Health        uint16
+	Speed         float32
+	Reach         float32
+	EnchantPoints uint16
+	ChopMin       uint8
+	ChopMax       uint8
+	SlashMin      uint8
+	SlashMax      uint8
+	ThrustMin     uint8
+	ThrustMax     uint8
+	Flags         Flags
+}
+
+func (t *WPDTField) Tag() esm.SubrecordTag { return WPDT }
+
+// Size implements esm.Sized.
+func (t *WPDTField) Size() int { return 32 }
+
+// Layout implements esm.Structured.
+func (t *WPDTField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Weight", Type: "f32", Offset: 0, Size: 4},
+		{Name: "Value", Type: "i32", Offset: 4, Size: 4},
+		{Name: "Kind", Type: "u16", Offset: 8, Size: 2},
+		{Name: "Health", Type: "u16", Offset: 10, Size: 2},
+		{Name: "Speed", Type: "f32", Offset: 12, Size: 4},
+		{Name: "Reach", Type: "f32", Offset: 16, Size: 4},
+		{Name: "EnchantPoints", Type: "u16", Offset: 20, Size: 2},
+		{Name: "ChopMin", Type: "u8", Offset: 22, Size: 1},
+		{Name: "ChopMax", Type: "u8", Offset: 23, Size: 1},
+		{Name: "SlashMin", Type: "u8", Offset: 24, Size: 1},
+		{Name: "SlashMax", Type: "u8", Offset: 25, Size: 1},
+		{Name: "ThrustMin", Type: "u8", Offset: 26, Size: 1},
+		{Name: "ThrustMax", Type: "u8", Offset: 27, Size: 1},
+		{Name: "Flags", Type: "u32", Offset: 28, Size: 4},
+	}
+}
+
+func (s *WPDTField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+	if len(sub.Data) != 32 {
+		return fmt.Errorf("WPDT must be 32 bytes, got %d", len(sub.Data))
+	}
+	d := sub.Data
+	s.Weight = math.Float32frombits(binary.LittleEndian.Uint32(d[0:4]))
+	s.Value = int32(binary.LittleEndian.Uint32(d[4:8]))
+	s.Kind = Kind(binary.LittleEndian.Uint16(d[8:10]))
+	s.Health = binary.LittleEndian.Uint16(d[10:12])
+	s.Speed = math.Float32frombits(binary.LittleEndian.Uint32(d[12:16]))
+	s.Reach = math.Float32frombits(binary.LittleEndian.Uint32(d[16:20]))
+	s.EnchantPoints = binary.LittleEndian.Uint16(d[20:22])
+	s.ChopMin = d[22]
+	s.ChopMax = d[23]
+	s.SlashMin = d[24]
+	s.SlashMax = d[25]
+	s.ThrustMin = d[26]
+	s.ThrustMax = d[27]
+	s.Flags = Flags(binary.LittleEndian.Uint32(d[28:32]))
+	return nil
+}
+
+func (s *WPDTField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+	d := make([]byte, 32)
+	binary.LittleEndian.PutUint32(d[0:4], math.Float32bits(float32(s.Weight)))
+	binary.LittleEndian.PutUint32(d[4:8], uint32(s.Value))
+	binary.LittleEndian.PutUint16(d[8:10], uint16(s.Kind))
+	binary.LittleEndian.PutUint16(d[10:12], uint16(s.Health))
+	binary.LittleEndian.PutUint32(d[12:16], math.Float32bits(float32(s.Speed)))
+	binary.LittleEndian.PutUint32(d[16:20], math.Float32bits(float32(s.Reach)))
+	binary.LittleEndian.PutUint16(d[20:22], uint16(s.EnchantPoints))
+	d[22] = byte(s.ChopMin)
+	d[23] = byte(s.ChopMax)
+	d[24] = byte(s.SlashMin)
+	d[25] = byte(s.SlashMax)
+	d[26] = byte(s.ThrustMin)
+	d[27] = byte(s.ThrustMax)
+	binary.LittleEndian.PutUint32(d[28:32], uint32(s.Flags))
+	return &esm.Subrecord{Tag: s.Tag(), Data: d}, nil
+}
+
+// Weapon name.
+const FNAM esm.SubrecordTag = "FNAM"
+
+// Weapon name.
+type FNAMField struct{ Value string }
+
+func (t *FNAMField) Tag() esm.SubrecordTag { return FNAM }
+
+// Layout implements esm.Structured.
+func (t *FNAMField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *FNAMField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *FNAMField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode FNAM: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Model filename.
+const MODL esm.SubrecordTag = "MODL"
+
+// Model filename.
+type MODLField struct{ Value string }
+
+func (t *MODLField) Tag() esm.SubrecordTag { return MODL }
+
+// Layout implements esm.Structured.
+func (t *MODLField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *MODLField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *MODLField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode MODL: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Weapon ID.
+const NAME esm.SubrecordTag = "NAME"
+
+// Weapon ID.
+type NAMEField struct{ Value string }
+
+func (t *NAMEField) Tag() esm.SubrecordTag { return NAME }
+
+// Layout implements esm.Structured.
+func (t *NAMEField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *NAMEField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *NAMEField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode NAME: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Kind is the kind of weapon, from WPDT.
+type Kind uint16
+
+const (
+	ShortBladeOneHandKind Kind = 0
+	LongBladeOneHandKind  Kind = 1
+	LongBladeTwoCloseKind Kind = 2
+	BluntOneHandKind      Kind = 3
+	BluntTwoCloseKind     Kind = 4
+	BluntTwoWideKind      Kind = 5
+	SpearTwoWideKind      Kind = 6
+	AxeOneHandKind        Kind = 7
+	AxeTwoHandKind        Kind = 8
+	MarksmanBowKind       Kind = 9
+	MarksmanCrossbowKind  Kind = 10
+	MarksmanThrownKind    Kind = 11
+	ArrowKind             Kind = 12
+	BoltKind              Kind = 13
+)
+
+func (e Kind) String() string {
+	switch e {
+	case ShortBladeOneHandKind:
+		return "ShortBladeOneHand"
+	case LongBladeOneHandKind:
+		return "LongBladeOneHand"
+	case LongBladeTwoCloseKind:
+		return "LongBladeTwoClose"
+	case BluntOneHandKind:
+		return "BluntOneHand"
+	case BluntTwoCloseKind:
+		return "BluntTwoClose"
+	case BluntTwoWideKind:
+		return "BluntTwoWide"
+	case SpearTwoWideKind:
+		return "SpearTwoWide"
+	case AxeOneHandKind:
+		return "AxeOneHand"
+	case AxeTwoHandKind:
+		return "AxeTwoHand"
+	case MarksmanBowKind:
+		return "MarksmanBow"
+	case MarksmanCrossbowKind:
+		return "MarksmanCrossbow"
+	case MarksmanThrownKind:
+		return "MarksmanThrown"
+	case ArrowKind:
+		return "Arrow"
+	case BoltKind:
+		return "Bolt"
+	}
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// MarshalText encodes e by name, or as a number if it has none.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+// UnmarshalText accepts a name or a number.
+func (e *Kind) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "ShortBladeOneHand":
+		*e = ShortBladeOneHandKind
+		return nil
+	case "LongBladeOneHand":
+		*e = LongBladeOneHandKind
+		return nil
+	case "LongBladeTwoClose":
+		*e = LongBladeTwoCloseKind
+		return nil
+	case "BluntOneHand":
+		*e = BluntOneHandKind
+		return nil
+	case "BluntTwoClose":
+		*e = BluntTwoCloseKind
+		return nil
+	case "BluntTwoWide":
+		*e = BluntTwoWideKind
+		return nil
+	case "SpearTwoWide":
+		*e = SpearTwoWideKind
+		return nil
+	case "AxeOneHand":
+		*e = AxeOneHandKind
+		return nil
+	case "AxeTwoHand":
+		*e = AxeTwoHandKind
+		return nil
+	case "MarksmanBow":
+		*e = MarksmanBowKind
+		return nil
+	case "MarksmanCrossbow":
+		*e = MarksmanCrossbowKind
+		return nil
+	case "MarksmanThrown":
+		*e = MarksmanThrownKind
+		return nil
+	case "Arrow":
+		*e = ArrowKind
+		return nil
+	case "Bolt":
+		*e = BoltKind
+		return nil
+	}
+	v, err := strconv.ParseUint(string(text), 0, 8*2)
+	if err != nil {
+		return fmt.Errorf("unknown Kind %q", text)
+	}
+	*e = Kind(v)
+	return nil
+}
+
+// Flags are weapon flags, from WPDT.
+type Flags uint32
+
+const (
+	// Hits creatures that only magic weapons can hit.
+	IgnoreResistanceFlag Flags = 0x01
+	SilverFlag           Flags = 0x02
+)
+
+// flagsNames lists the named bits of Flags, in order.
+var flagsNames = []struct {
+	flag Flags
+	name string
+}{
+	{IgnoreResistanceFlag, "IgnoreResistance"},
+	{SilverFlag, "Silver"},
+}
+
+// Has reports whether every bit of flag is set.
+func (f Flags) Has(flag Flags) bool { return f&flag == flag }
+
+// Set sets or clears the bits of flag.
+func (f *Flags) Set(flag Flags, on bool) {
+	if on {
+		*f |= flag
+	} else {
+		*f &^= flag
+	}
+}
+
+// Names returns the names of the set bits. Unnamed bits are returned
+// together as one hex number.
+func (f Flags) Names() []string {
+	names := []string{}
+	for _, n := range flagsNames {
+		if f.Has(n.flag) {
+			names = append(names, n.name)
+			f &^= n.flag
+		}
+	}
+	if f != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint32(f)))
+	}
+	return names
+}
+
+func (f Flags) String() string {
+	if f == 0 {
+		return "0"
+	}
+	return strings.Join(f.Names(), "|")
+}
+
+// ParseFlags combines bit names, as returned by Names, into a Flags.
+// Numbers are accepted too.
+func ParseFlags(names []string) (Flags, error) {
+	var f Flags
+next:
+	for _, name := range names {
+		for _, n := range flagsNames {
+			if n.name == name {
+				f |= n.flag
+				continue next
+			}
+		}
+		v, err := strconv.ParseUint(name, 0, 8*4)
+		if err != nil {
+			return 0, fmt.Errorf("unknown Flags %q", name)
+		}
+		f |= Flags(v)
+	}
+	return f, nil
+}
+
+// MarshalJSON encodes f as a list of names.
+func (f Flags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(f.Names())
+}
+
+// UnmarshalJSON accepts a list of names, or a number.
+func (f *Flags) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v uint32
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// MarshalYAML encodes f as a list of names.
+func (f Flags) MarshalYAML() (any, error) {
+	return f.Names(), nil
+}
+
+// UnmarshalYAML accepts a list of names, or a number.
+func (f *Flags) UnmarshalYAML(unmarshal func(any) error) error {
+	var names []string
+	if err := unmarshal(&names); err != nil {
+		var v uint32
+		if unmarshal(&v) != nil {
+			return err
+		}
+		*f = Flags(v)
+		return nil
+	}
+	v, err := ParseFlags(names)
+	if err != nil {
+		return err
+	}
+	*f = v
+	return nil
+}
+
+// Script ID.
+const SCRI esm.SubrecordTag = "SCRI"
+
+// Script ID.
+type SCRIField struct{ Value string }
+
+func (t *SCRIField) Tag() esm.SubrecordTag { return SCRI }
+
+// Layout implements esm.Structured.
+func (t *SCRIField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *SCRIField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *SCRIField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode SCRI: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
+
+// Inventory icon filename.
+const ITEX esm.SubrecordTag = "ITEX"
+
+// Inventory icon filename.
+type ITEXField struct{ Value string }
+
+func (t *ITEXField) Tag() esm.SubrecordTag { return ITEX }
+
+// Layout implements esm.Structured.
+func (t *ITEXField) Layout() esm.Layout {
+	return esm.Layout{
+		{Name: "Value", Type: "z", Offset: 0, Size: -1},
+	}
+}
+
+func (s *ITEXField) Unmarshal(sub *esm.Subrecord) error {
+	if s == nil || sub == nil {
+		return esm.ErrArgumentNil
+	}
+
+	if len(sub.Data) == 0 {
+		return fmt.Errorf("zstring subrecord has no data")
+	}
+	if sub.Data[len(sub.Data)-1] != 0 {
+		return fmt.Errorf("zstring subrecord not null-terminated")
+	}
+	s.Value = util.DecodeString(sub.Data[:len(sub.Data)-1])
+
+	return nil
+}
+
+func (s *ITEXField) Marshal() (*esm.Subrecord, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	raw, err := util.EncodeString(s.Value)
+	if err != nil {
+		return nil, fmt.Errorf("encode ITEX: %w", err)
+	}
+	return &esm.Subrecord{Tag: s.Tag(), Data: append(raw, 0)}, nil
+}
@@ -0,0 +1,83 @@
+// Code generated by generator/gen.go; DO NOT EDIT.
+package weap
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm/internal/gentest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGenerated checks every type generated from subrecords.json.
+func TestGenerated(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("ENAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ENAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("FNAM", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &FNAMField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Flags", func(t *testing.T) {
+		for range 32 {
+			want := Flags(r.Uint64())
+			got, err := ParseFlags(want.Names())
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("ITEX", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &ITEXField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("Kind", func(t *testing.T) {
+		for range 32 {
+			want := Kind(r.Uint64())
+			var got Kind
+			text, err := want.MarshalText()
+			require.NoError(t, err)
+			require.NoError(t, got.UnmarshalText(text))
+			require.Equal(t, want, got)
+		}
+	})
+	t.Run("MODL", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &MODLField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("NAME", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &NAMEField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("SCRI", func(t *testing.T) {
+		for range 32 {
+			gentest.RoundTrip(t, &SCRIField{Value: gentest.String(r, 64)})
+		}
+	})
+	t.Run("WPDT", func(t *testing.T) {
+		for range 32 {
+			s := &WPDTField{}
+			s.Weight = gentest.Float32(r)
+			s.Value = int32(r.Uint64())
+			s.Kind = Kind(r.Uint64())
+			s.Health = uint16(r.Uint64())
+			s.Speed = gentest.Float32(r)
+			s.Reach = gentest.Float32(r)
+			s.EnchantPoints = uint16(r.Uint64())
+			s.ChopMin = uint8(r.Uint64())
+			s.ChopMax = uint8(r.Uint64())
+			s.SlashMin = uint8(r.Uint64())
+			s.SlashMax = uint8(r.Uint64())
+			s.ThrustMin = uint8(r.Uint64())
+			s.ThrustMax = uint8(r.Uint64())
+			s.Flags = Flags(r.Uint64())
+			gentest.RoundTrip(t, s)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+// WEAP records contain weapons.
+//
+//go:generate go run ../generator/gen.go subrecords.json
+//go:generate go run ../generator/gen.go records.json
+package weap
+
+import "github.com/ernmw/omwpacker/esm"
+
+// WEAP handles https://en.uesp.net/wiki/Morrowind_Mod:Mod_File_Format/WEAP
+const WEAP esm.RecordTag = "WEAP"
+
+func init() {
+	esm.RegisterRecord(WEAP, func(rec *esm.Record, opts ...esm.ParseOption) (esm.ParsedRecord, error) {
+		w, err := ParseWeapon(rec, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return w, nil
+	})
+	esm.RegisterSubrecords(WEAP,
+		&NAMEField{},
+		&MODLField{},
+		&FNAMField{},
+		&WPDTField{},
+		&ITEXField{},
+		&ENAMField{},
+		&SCRIField{},
+	)
+}
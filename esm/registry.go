@@ -0,0 +1,157 @@
+package esm
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// ErrNotRegistered is returned when no decoder is registered for a tag.
+var ErrNotRegistered = errors.New("not registered")
+
+// ParsedRecord is an unmarshalled Record.
+type ParsedRecord interface {
+	// Tag is the tag of the record this was parsed from.
+	Tag() RecordTag
+	// OrderedRecords marshals the parsed record back into subrecords,
+	// in the order they should be written.
+	// If this instance is nil, this should return (nil, nil).
+	OrderedRecords() ([]*Subrecord, error)
+}
+
+// RecordDecoder builds a typed record from a raw Record.
+type RecordDecoder func(rec *Record, opts ...ParseOption) (ParsedRecord, error)
+
+var registry = struct {
+	mux        sync.RWMutex
+	records    map[RecordTag]RecordDecoder
+	subrecords map[RecordTag]map[SubrecordTag]reflect.Type
+}{
+	records:    map[RecordTag]RecordDecoder{},
+	subrecords: map[RecordTag]map[SubrecordTag]reflect.Type{},
+}
+
+// RegisterRecord makes decode the decoder for records with the given tag.
+// Record packages call this from init.
+func RegisterRecord(tag RecordTag, decode RecordDecoder) {
+	if decode == nil {
+		panic(fmt.Sprintf("esm: nil decoder for %q", tag))
+	}
+	registry.mux.Lock()
+	defer registry.mux.Unlock()
+	if _, ok := registry.records[tag]; ok {
+		panic(fmt.Sprintf("esm: record %q registered twice", tag))
+	}
+	registry.records[tag] = decode
+}
+
+// RegisterSubrecords registers each of the given parsed subrecords as the
+// decoder for its Tag() when it appears in records with the tag rec.
+// Each prototype must be a pointer to a struct; Decode allocates a fresh
+// instance of the same type for every subrecord.
+// Record packages call this from init.
+func RegisterSubrecords(rec RecordTag, prototypes ...ParsedSubrecord) {
+	registry.mux.Lock()
+	defer registry.mux.Unlock()
+	subs, ok := registry.subrecords[rec]
+	if !ok {
+		subs = map[SubrecordTag]reflect.Type{}
+		registry.subrecords[rec] = subs
+	}
+	for _, p := range prototypes {
+		typ := reflect.TypeOf(p)
+		if typ.Kind() != reflect.Pointer {
+			panic(fmt.Sprintf("esm: subrecord %T in %q is not a pointer", p, rec))
+		}
+		if _, ok := subs[p.Tag()]; ok {
+			panic(fmt.Sprintf("esm: subrecord %q in %q registered twice", p.Tag(), rec))
+		}
+		subs[p.Tag()] = typ.Elem()
+	}
+}
+
+// RegisteredRecords lists the record tags that have a decoder, sorted.
+func RegisteredRecords() []RecordTag {
+	registry.mux.RLock()
+	defer registry.mux.RUnlock()
+	tags := make([]RecordTag, 0, len(registry.records))
+	for tag := range registry.records {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// RegisteredSubrecords lists the subrecord tags that have a decoder
+// within records of the tag rec, sorted.
+func RegisteredSubrecords(rec RecordTag) []SubrecordTag {
+	registry.mux.RLock()
+	defer registry.mux.RUnlock()
+	tags := make([]SubrecordTag, 0, len(registry.subrecords[rec]))
+	for tag := range registry.subrecords[rec] {
+		tags = append(tags, tag)
+	}
+	slices.Sort(tags)
+	return tags
+}
+
+// Decode rec into the typed record registered for its tag.
+func Decode(rec *Record, opts ...ParseOption) (ParsedRecord, error) {
+	if rec == nil {
+		return nil, ErrArgumentNil
+	}
+	registry.mux.RLock()
+	decode, ok := registry.records[rec.Tag]
+	registry.mux.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("record %q: %w", rec.Tag, ErrNotRegistered)
+	}
+	parsed, err := decode(rec, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("decode %q: %w", rec.Tag, err)
+	}
+	return parsed, nil
+}
+
+// Encode p back into a Record.
+// The returned record has no flags or plugin metadata set.
+func Encode(p ParsedRecord) (*Record, error) {
+	if p == nil {
+		return nil, ErrArgumentNil
+	}
+	subs, err := p.OrderedRecords()
+	if err != nil {
+		return nil, fmt.Errorf("encode %q: %w", p.Tag(), err)
+	}
+	return &Record{Tag: p.Tag(), Subrecords: subs}, nil
+}
+
+// NewSubrecord returns an empty instance of the parsed subrecord registered
+// for the tag sub within records of the tag rec.
+func NewSubrecord(rec RecordTag, sub SubrecordTag) (ParsedSubrecord, error) {
+	registry.mux.RLock()
+	typ, ok := registry.subrecords[rec][sub]
+	registry.mux.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("subrecord %q in %q: %w", sub, rec, ErrNotRegistered)
+	}
+	return reflect.New(typ).Interface().(ParsedSubrecord), nil
+}
+
+// DecodeSubrecord unmarshals sub, which belongs to a record with the tag rec,
+// into the parsed subrecord registered for it.
+func DecodeSubrecord(rec RecordTag, sub *Subrecord) (ParsedSubrecord, error) {
+	if sub == nil {
+		return nil, ErrArgumentNil
+	}
+	p, err := NewSubrecord(rec, sub.Tag)
+	if err != nil {
+		return nil, err
+	}
+	if err := sub.UnmarshalTo(p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,51 @@
+package esm_test
+
+import (
+	"path"
+	"testing"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/cell"
+	"github.com/ernmw/omwpacker/esm/record/tes3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry(t *testing.T) {
+	records, err := esm.ParsePluginFile(path.Join("testdata", "CELL.omwaddon"))
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	t.Run("decode record", func(t *testing.T) {
+		parsed, err := esm.Decode(records[1])
+		require.NoError(t, err)
+		cellRec, ok := parsed.(*cell.CellRecord)
+		require.True(t, ok)
+		require.Equal(t, "Balmora, Caius Cosades' House", cellRec.NAME.Value)
+
+		encoded, err := esm.Encode(parsed)
+		require.NoError(t, err)
+		require.Equal(t, cell.CELL, encoded.Tag)
+		require.Equal(t, records[1].Subrecords, encoded.Subrecords)
+	})
+
+	t.Run("unregistered record", func(t *testing.T) {
+		_, err := esm.Decode(&esm.Record{Tag: "XXXX"})
+		require.ErrorIs(t, err, esm.ErrNotRegistered)
+	})
+
+	t.Run("decode subrecord", func(t *testing.T) {
+		parsed, err := esm.DecodeSubrecord(tes3.TES3, getSubrecord(records[0], tes3.HEDR))
+		require.NoError(t, err)
+		h, ok := parsed.(*tes3.HEDRdata)
+		require.True(t, ok)
+		require.Equal(t, float32(1.3), h.Version)
+
+		_, err = esm.DecodeSubrecord(tes3.TES3, getSubrecord(records[0], tes3.MAST))
+		require.ErrorIs(t, err, esm.ErrNotRegistered)
+	})
+
+	t.Run("registered", func(t *testing.T) {
+		require.Contains(t, esm.RegisteredRecords(), cell.CELL)
+		require.Contains(t, esm.RegisteredSubrecords(cell.CELL), cell.AMBI)
+	})
+}
@@ -0,0 +1,34 @@
+package esm
+
+import "slices"
+
+// UnknownSubrecord is a subrecord that a typed record parser didn't
+// recognize. Typed records keep them so that re-encoding doesn't drop data.
+type UnknownSubrecord struct {
+	// Index is the position of Sub among the subrecords the typed record
+	// was parsed from.
+	Index int
+	Sub   *Subrecord
+}
+
+// InsertUnknown puts each unknown subrecord back into known at its Index.
+// unknown must be sorted by Index, as typed parsers produce it. Entries
+// whose Index is past the end are appended.
+func InsertUnknown(known []*Subrecord, unknown []UnknownSubrecord) []*Subrecord {
+	for _, u := range unknown {
+		if u.Index <= len(known) {
+			known = slices.Insert(known, u.Index, u.Sub)
+		} else {
+			known = append(known, u.Sub)
+		}
+	}
+	return known
+}
+
+// Deleted reports whether unknown, the unknown subrecords of a typed
+// record, hold DELE, so whether the record deletes its object.
+func Deleted(unknown []UnknownSubrecord) bool {
+	return slices.ContainsFunc(unknown, func(u UnknownSubrecord) bool {
+		return u.Sub.Tag == DELE
+	})
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/record/leveled"
+	"github.com/ernmw/omwpacker/esm/record/tes3"
+	"github.com/ernmw/omwpacker/safewrite"
+	"github.com/spf13/pflag"
+	"go.coder.com/cli"
+)
+
+// levelmergeCmd merges the leveled lists of a load order into one plugin.
+type levelmergeCmd struct {
+	out      string // -o output
+	encoding string // -e
+	jobs     int    // -j
+	backup   backupFlags
+}
+
+func (cmd *levelmergeCmd) Spec() cli.CommandSpec {
+	return cli.CommandSpec{
+		Name:    "levelmerge",
+		Usage:   "<openmw.cfg> [-o output] [-e encoding] [-j jobs] [--backup mode]",
+		Aliases: []string{"lm"},
+		Desc:    "Merge the leveled lists of every plugin in an openmw.cfg into an .omwaddon, so no plugin's additions are lost.",
+	}
+}
+
+func (cmd *levelmergeCmd) RegisterFlags(fl *pflag.FlagSet) {
+	fl.StringVarP(&cmd.out, "output", "o", "", "Output file path (defaults to merged_lists.omwaddon next to the cfg). Load it last.")
+	fl.StringVarP(&cmd.encoding, "encoding", "e", "", "Code page of plugin strings: win1250, win1251 or win1252. Defaults to the cfg's encoding= setting, or win1252.")
+	fl.IntVarP(&cmd.jobs, "jobs", "j", 0, "Number of plugins to parse at once. Defaults to the number of CPUs.")
+	cmd.backup.RegisterFlags(fl)
+}
+
+func (cmd *levelmergeCmd) Run(fl *pflag.FlagSet) {
+	if fl.NArg() < 1 {
+		fl.Usage()
+		fmt.Fprintln(os.Stderr, "openmw.cfg required")
+		os.Exit(2)
+	}
+	inPath := fl.Arg(0)
+	outPath := cmd.out
+	if outPath == "" {
+		outPath = filepath.Join(filepath.Dir(inPath), "merged_lists.omwaddon")
+	}
+
+	if !fileExists(inPath) {
+		fmt.Printf("💀 Failed: File %q not found\n", inPath)
+		os.Exit(1)
+	}
+	if !strings.EqualFold(filepath.Ext(inPath), ".cfg") {
+		fmt.Printf("💀 Failed: %q is not an openmw.cfg\n", inPath)
+		os.Exit(1)
+	}
+
+	policy, err := cmd.backup.policy()
+	if err != nil {
+		fmt.Printf("💀 Failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	plugins, err := inputPlugins(inPath, cmd.encoding)
+	if err != nil {
+		fmt.Printf("💀 Failed: %v\n", err)
+		os.Exit(1)
+	}
+	// A previous merge must not feed into this one.
+	plugins = slices.DeleteFunc(plugins, func(p string) bool {
+		return strings.EqualFold(filepath.Base(p), filepath.Base(outPath))
+	})
+
+	// Only leveled lists are merged, so nothing else is kept in memory.
+	loaded, err := loadPlugins(plugins, cmd.jobs, func(rec *esm.Record) bool {
+		return slices.Contains(leveled.Tags, rec.Tag)
+	})
+	if err != nil {
+		printLoadErrors(err)
+		os.Exit(1)
+	}
+
+	merged, err := leveled.MergeLoadOrder(loaded)
+	if err != nil {
+		fmt.Printf("💀 Failed: %v\n", err)
+		os.Exit(1)
+	}
+	if len(merged) == 0 {
+		fmt.Println("🩷 No leveled lists need merging")
+		return
+	}
+
+	backupFile, err := cmd.writeMerged(outPath, merged, policy)
+	if backupFile != "" {
+		fmt.Printf("Backed up %q → %q\n", outPath, backupFile)
+	}
+	if err != nil {
+		fmt.Printf("💀 Failed: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("🩵 Merged %d leveled lists into %q\n", len(merged), outPath)
+}
+
+func (cmd *levelmergeCmd) writeMerged(outPath string, merged []*esm.Record, policy safewrite.BackupPolicy) (string, error) {
+	header, err := tes3.NewTES3Record("", "Merged leveled lists. Made with https://github.com/ernmw/omwpacker/")
+	if err != nil {
+		return "", fmt.Errorf("failed to make TES3 record: %v", err)
+	}
+	outRecords := append([]*esm.Record{header}, merged...)
+	backupFile, err := safewrite.WriteFile(outPath, policy, func(w io.Writer) error {
+		return esm.WriteRecords(w, slices.Values(outRecords))
+	})
+	if err != nil {
+		return backupFile, fmt.Errorf("failed to write file %q: %w", outPath, err)
+	}
+	return backupFile, nil
+}
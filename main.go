@@ -2,11 +2,9 @@ package main
 
 import (
 	"errors"
-	"fmt"
-	"io"
 	"os"
-	"path/filepath"
 
+	"github.com/ernmw/omwpacker/safewrite"
 	"github.com/spf13/pflag"
 	"go.coder.com/cli"
 )
@@ -16,26 +14,25 @@ func fileExists(path string) bool {
 	return !errors.Is(err, os.ErrNotExist)
 }
 
-func backup(path string) (string, error) {
-	if fileExists(path) {
-		source, err := os.Open(path)
-		if err != nil {
-			return "", fmt.Errorf("failed to open file %q: %w", path, err)
-		}
-		defer source.Close()
+// backupFlags configures how commands back up files they overwrite.
+type backupFlags struct {
+	mode string // --backup
+	dir  string // --backup-dir
+	keep int    // --backup-keep
+}
 
-		tmp, err := os.CreateTemp("", filepath.Base(path))
-		if err != nil {
-			return "", fmt.Errorf("failed to create temporary file %q: %w", path, err)
-		}
-		defer tmp.Close()
+func (b *backupFlags) RegisterFlags(fl *pflag.FlagSet) {
+	fl.StringVar(&b.mode, "backup", safewrite.BackupBak.String(), "How to back up files before overwriting them: none, bak (<file>.bak) or timestamped.")
+	fl.StringVar(&b.dir, "backup-dir", safewrite.DefaultBackupDir, "Directory for timestamped backups, relative to the overwritten file.")
+	fl.IntVar(&b.keep, "backup-keep", 0, "Number of timestamped backups to keep per file (0 keeps all).")
+}
 
-		if _, err := io.Copy(tmp, source); err != nil {
-			return "", fmt.Errorf("failed to backup file %q: %w", path, err)
-		}
-		return tmp.Name(), nil
+func (b *backupFlags) policy() (safewrite.BackupPolicy, error) {
+	mode, err := safewrite.ParseBackupMode(b.mode)
+	if err != nil {
+		return safewrite.BackupPolicy{}, err
 	}
-	return "", nil
+	return safewrite.BackupPolicy{Mode: mode, Dir: b.dir, Keep: b.keep}, nil
 }
 
 // root command; provides top-level metadata and subcommands.
@@ -61,6 +58,8 @@ func (r *root) Subcommands() []cli.Command {
 		new(packCmd),
 		new(extractCmd),
 		new(readCmd),
+		new(validateCmd),
+		new(levelmergeCmd),
 	}
 }
 
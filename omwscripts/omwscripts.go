@@ -10,11 +10,11 @@ import (
 	"github.com/ernmw/omwpacker/esm/record/lua"
 )
 
-var flagsByName = map[string]uint32{
-	"GLOBAL": 1 << 0,
-	"CUSTOM": 1 << 1,
-	"PLAYER": 1 << 2,
-	"MENU":   1 << 4,
+var flagsByName = map[string]lua.ScriptFlags{
+	"GLOBAL": lua.GlobalFlag,
+	"CUSTOM": lua.CustomFlag,
+	"PLAYER": lua.PlayerFlag,
+	"MENU":   lua.MenuFlag,
 }
 
 var tagsByName = map[string]esm.RecordTag{
@@ -60,7 +60,7 @@ func Package(content string) ([]*esm.Subrecord, error) {
 		for _, attach := range strings.Split(attachList, ",") {
 			key := strings.ToUpper(strings.TrimSpace(attach))
 			if flag, ok := flagsByName[key]; ok {
-				luaf.Flags = luaf.Flags | flag
+				luaf.Flags.Set(flag, true)
 			} else if target, ok := tagsByName[key]; ok {
 				luaf.Targets = append(luaf.Targets, string(target))
 			} else {
@@ -2,28 +2,33 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"slices"
 	"strings"
 
 	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/codepage"
 	"github.com/ernmw/omwpacker/esm/record/lua"
 	"github.com/ernmw/omwpacker/esm/record/tes3"
 	"github.com/ernmw/omwpacker/omwscripts"
+	"github.com/ernmw/omwpacker/safewrite"
 	"github.com/spf13/pflag"
 	"go.coder.com/cli"
 )
 
 // packCmd implements the pack subcommand.
 type packCmd struct {
-	out string // -o output
+	out      string // -o output
+	encoding string // -e encoding
+	backup   backupFlags
 }
 
 func (cmd *packCmd) Spec() cli.CommandSpec {
 	return cli.CommandSpec{
 		Name:    "pack",
-		Usage:   "<input> [-o output]",
+		Usage:   "<input> [-o output] [-e encoding] [--backup mode]",
 		Aliases: []string{"p"},
 		Desc:    "Package a .omwscripts file into an .omwaddon (or inject into existing addon).",
 	}
@@ -31,6 +36,8 @@ func (cmd *packCmd) Spec() cli.CommandSpec {
 
 func (cmd *packCmd) RegisterFlags(fl *pflag.FlagSet) {
 	fl.StringVarP(&cmd.out, "output", "o", "", "Output file path (defaults to <input>.omwaddon)")
+	fl.StringVarP(&cmd.encoding, "encoding", "e", codepage.Win1252.Name(), "Code page of plugin strings: win1250, win1251 or win1252.")
+	cmd.backup.RegisterFlags(fl)
 }
 
 func (cmd *packCmd) Run(fl *pflag.FlagSet) {
@@ -53,30 +60,39 @@ func (cmd *packCmd) Run(fl *pflag.FlagSet) {
 		os.Exit(1)
 	}
 
-	// backup output if exists
-	if backupFile, err := backup(outPath); err != nil {
-		fmt.Printf("💀 Failed: Couldn't back up %q: %v\n", outPath, err)
+	enc, err := codepage.ByName(cmd.encoding)
+	if err != nil {
+		fmt.Printf("💀 Failed: %v\n", err)
+		os.Exit(1)
+	}
+	codepage.Set(enc)
+
+	policy, err := cmd.backup.policy()
+	if err != nil {
+		fmt.Printf("💀 Failed: %v\n", err)
 		os.Exit(1)
-	} else if backupFile != "" {
-		fmt.Printf("Backed up %q → %q\n", outPath, backupFile)
 	}
 
 	fmt.Printf("Packing %q → %q\n", inPath, outPath)
-	if err := cmd.packCommand(inPath, outPath); err != nil {
+	backupFile, err := cmd.packCommand(inPath, outPath, policy)
+	if backupFile != "" {
+		fmt.Printf("Backed up %q → %q\n", outPath, backupFile)
+	}
+	if err != nil {
 		fmt.Printf("💀 Failed: %v\n", err)
 		os.Exit(1)
 	}
 	fmt.Printf("🩵 Done: %q\n", outPath)
 }
 
-func (cmd *packCmd) packCommand(inPath, outPath string) error {
+func (cmd *packCmd) packCommand(inPath, outPath string, policy safewrite.BackupPolicy) (string, error) {
 	var outRecords []*esm.Record
 
 	if fileExists(outPath) {
 		var err error
 		outRecords, err = esm.ParsePluginFile(outPath)
 		if err != nil {
-			return fmt.Errorf("failed to parse %q: %v", outPath, err)
+			return "", fmt.Errorf("failed to parse %q: %v", outPath, err)
 		}
 		// remove existing LUAF/LUAS entries under LUAL
 		for _, rec := range outRecords {
@@ -89,18 +105,18 @@ func (cmd *packCmd) packCommand(inPath, outPath string) error {
 	} else {
 		firstRec, err := tes3.NewTES3Record("", "Made with https://github.com/ernmw/omwpacker/")
 		if err != nil {
-			return fmt.Errorf("failed to make TES3 record: %v", err)
+			return "", fmt.Errorf("failed to make TES3 record: %v", err)
 		}
 		outRecords = []*esm.Record{firstRec}
 	}
 
 	inContents, err := os.ReadFile(inPath)
 	if err != nil {
-		return fmt.Errorf("failed to read input file: %w", err)
+		return "", fmt.Errorf("failed to read input file: %w", err)
 	}
 	subRecs, err := omwscripts.Package(string(inContents))
 	if err != nil {
-		return fmt.Errorf("failed to package file %q: %w", inPath, err)
+		return "", fmt.Errorf("failed to package file %q: %w", inPath, err)
 	}
 
 	found := false
@@ -117,14 +133,11 @@ func (cmd *packCmd) packCommand(inPath, outPath string) error {
 		})
 	}
 
-	writeOut, err := os.Create(outPath)
+	backupFile, err := safewrite.WriteFile(outPath, policy, func(w io.Writer) error {
+		return esm.WriteRecords(w, slices.Values(outRecords))
+	})
 	if err != nil {
-		return fmt.Errorf("failed to create output file %q: %w", outPath, err)
-	}
-	defer writeOut.Close()
-
-	if err := esm.WriteRecords(writeOut, slices.Values(outRecords)); err != nil {
-		return fmt.Errorf("failed to write file %q: %w", outPath, err)
+		return backupFile, fmt.Errorf("failed to write file %q: %w", outPath, err)
 	}
-	return nil
+	return backupFile, nil
 }
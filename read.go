@@ -2,16 +2,23 @@ package main
 
 import (
 	"bytes"
+	"cmp"
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
 	"unicode"
 
 	"github.com/ernmw/omwpacker/cfg"
 	"github.com/ernmw/omwpacker/esm"
+	"github.com/ernmw/omwpacker/esm/codepage"
+	_ "github.com/ernmw/omwpacker/esm/record"
 	"github.com/spf13/pflag"
 	"go.coder.com/cli"
 	"golang.org/x/term"
@@ -22,12 +29,18 @@ type readCmd struct {
 	record    string // -r record
 	subrecord string // -s subrecord
 	filter    string // -f subrecordtag=string
+	decode    bool   // -d
+	annotate  bool   // -a
+	layout    string // --layout
+	encoding  string // -e
+	jobs      int    // -j
+	lenient   bool   // --lenient
 }
 
 func (cmd *readCmd) Spec() cli.CommandSpec {
 	return cli.CommandSpec{
 		Name:    "read",
-		Usage:   "<input> [-r record] [-s subrecord] [-f subrecordtag=string]",
+		Usage:   "<input> [-r record] [-s subrecord] [-f subrecordtag=string] [-d] [-a] [--layout spec] [-e encoding] [-j jobs] [--lenient]",
 		Aliases: []string{"r"},
 		Desc:    "Read and display contents of an .omwaddon/.esp/.esp/openmw.cfg.",
 	}
@@ -36,7 +49,13 @@ func (cmd *readCmd) Spec() cli.CommandSpec {
 func (cmd *readCmd) RegisterFlags(fl *pflag.FlagSet) {
 	fl.StringVarP(&cmd.record, "record", "r", "", "Display records of the given type. Specify multiples by delimiting with a comma.")
 	fl.StringVarP(&cmd.subrecord, "subrecord", "s", "", "Display subrecords of the given type. Specify multiples by delimiting with a comma.")
-	fl.StringVarP(&cmd.filter, "filter", "f", "", "Filter records to those that contain the given subrecord, and that subrecord contains the provided string. Example: 'NAME=Balmora'. Prefix the string with '0x' to interpret it as hex-encoded.")
+	fl.StringVarP(&cmd.filter, "filter", "f", "", "Filter records to those that contain the given subrecord, and that subrecord contains the provided string. Example: 'NAME=Balmora'. The string is matched in the plugins' code page; prefix it with '0x' to interpret it as hex-encoded.")
+	fl.BoolVarP(&cmd.decode, "decode", "d", false, "Display decoded values for subrecords with a registered decoder instead of hex.")
+	fl.BoolVarP(&cmd.annotate, "annotate", "a", false, "Display hex annotated with the byte range, name, type and value of each field, for subrecords with a known layout.")
+	fl.StringVar(&cmd.layout, "layout", "", "Annotate subrecords without a known layout using this one, such as 'f32,u32,z32,u8[4]'. Types are i8, u8, i16, u16, i32, u32, f32, zN for an N byte string and xN for N raw bytes; a bare z or x runs to the end. Fields may be named, as in 'scale:f32'. Implies -a.")
+	fl.BoolVar(&cmd.lenient, "lenient", false, "Recover from malformed plugins where possible, listing what was repaired, instead of failing.")
+	fl.IntVarP(&cmd.jobs, "jobs", "j", 0, "Number of plugins to parse at once. Defaults to the number of CPUs.")
+	fl.StringVarP(&cmd.encoding, "encoding", "e", "", "Code page of plugin strings: win1250, win1251 or win1252. Defaults to the cfg's encoding= setting, or win1252.")
 }
 
 func (cmd *readCmd) Run(fl *pflag.FlagSet) {
@@ -80,19 +99,38 @@ func (cmd *readCmd) Run(fl *pflag.FlagSet) {
 		subrecFilter = func(_ *esm.Subrecord) bool { return true }
 	}
 
+	var layout esm.Layout
+	if cmd.layout != "" {
+		var err error
+		if layout, err = esm.ParseLayout(cmd.layout); err != nil {
+			fmt.Printf("💀 Failed: Layout %q: %v\n", cmd.layout, err)
+			os.Exit(1)
+		}
+		cmd.annotate = true
+	}
+
+	plugins, err := inputPlugins(inPath, cmd.encoding)
+	if err != nil {
+		fmt.Printf("💀 Failed: %v\n", err)
+		os.Exit(1)
+	}
+
 	// set up filter
 	var filter func(rec *esm.Record) bool
 	tokens := strings.SplitN(cmd.filter, "=", 2)
 	if len(cmd.filter) > 0 && len(tokens) == 2 {
 		name := esm.SubrecordTag(strings.ToUpper(tokens[0]))
-		sub := []byte(tokens[1])
+		var sub []byte
 		if strings.HasPrefix(tokens[1], "0x") {
-			var err error
 			sub, err = hex.DecodeString(strings.TrimPrefix(tokens[1], "0x"))
 			if err != nil {
 				fmt.Printf("💀 Failed: String %q is not hex.\n", tokens[1])
 				os.Exit(1)
 			}
+		} else if sub, err = codepage.Current().Encode(tokens[1]); err != nil {
+			// Plugins store strings in their code page, not UTF-8.
+			fmt.Printf("💀 Failed: Filter %q: %v\n", tokens[1], err)
+			os.Exit(1)
 		}
 		filter = func(rec *esm.Record) bool {
 			return slices.ContainsFunc(rec.Subrecords, func(s *esm.Subrecord) bool {
@@ -113,42 +151,44 @@ func (cmd *readCmd) Run(fl *pflag.FlagSet) {
 		return recFilter(rec) && filter(rec)
 	}
 
-	var plugins []string
+	var diagnostics []esm.Diagnostic
+	parse := []esm.ParseOption{}
+	if cmd.lenient {
+		parse = append(parse, esm.WithLenient(), collectDiagnostics(&diagnostics))
+	}
+	// Records the filters exclude are dropped while loading, so a load
+	// order is never held in memory whole.
+	loaded, loadErr := loadPlugins(plugins, cmd.jobs, combinedRecordFilter, parse...)
 
-	if strings.EqualFold(filepath.Ext(inPath), ".cfg") {
-		env, err := cfg.Load(inPath)
-		if err != nil {
-			fmt.Printf("💀 Failed: %q couldn't be parsed: %v\n", inPath, err)
-			os.Exit(1)
+	for _, plugin := range loaded {
+		if plugin.Records == nil {
+			continue
 		}
-		plugins = env.Plugins
-	} else {
-		plugins = []string{inPath}
-	}
-	for _, plugin := range plugins {
 		if err := cmd.readCommand(
 			plugin,
-			combinedRecordFilter,
-			subrecFilter); err != nil {
-			fmt.Printf("💀 Failed parsing %s: %v\n", plugin, err)
+			subrecFilter,
+			layout); err != nil {
+			fmt.Printf("💀 Failed reading %s: %v\n", plugin.Path, err)
 			os.Exit(1)
 		}
 	}
 
+	printDiagnostics(plugins, diagnostics)
+	if loadErr != nil {
+		printLoadErrors(loadErr)
+		os.Exit(1)
+	}
+
 	fmt.Printf("🩷 Done reading %q\n", inPath)
 }
 
 func (cmd *readCmd) readCommand(
-	in string,
-	recordFilter func(rec *esm.Record) bool,
+	plugin *esm.Plugin,
 	subrecordFilter func(sub *esm.Subrecord) bool,
+	layout esm.Layout,
 ) error {
-
-	inRecords, err := esm.ParsePluginFile(in)
-	if err != nil {
-		return fmt.Errorf("failed to parse %q: %w", in, err)
-	}
-
+	in := plugin.Path
+	var err error
 	width := 120
 	if fd := int(os.Stdout.Fd()); term.IsTerminal(fd) {
 		width, _, err = term.GetSize(fd)
@@ -157,10 +197,7 @@ func (cmd *readCmd) readCommand(
 		}
 	}
 
-	for _, rec := range inRecords {
-		if !recordFilter(rec) {
-			continue
-		}
+	for _, rec := range plugin.Records {
 		headerPrinted := false
 		for _, subRec := range rec.Subrecords {
 			if !subrecordFilter(subRec) {
@@ -171,6 +208,20 @@ func (cmd *readCmd) readCommand(
 				headerPrinted = true
 			}
 			fmt.Printf("  %s:\n", subRec.Tag)
+			if cmd.annotate {
+				if fields, parsed := subrecordLayout(rec.Tag, subRec, layout); fields != nil {
+					if err = printAnnotated(width, fields, parsed, subRec.Data); err != nil {
+						return fmt.Errorf("printing %s/%s from %q", rec.Tag, subRec.Tag, in)
+					}
+					continue
+				}
+			}
+			if cmd.decode {
+				if parsed, err := esm.DecodeSubrecord(rec.Tag, subRec); err == nil {
+					fmt.Printf("    %+v\n", parsed)
+					continue
+				}
+			}
 			if err = printHex(width, subRec.Data); err != nil {
 				return fmt.Errorf("printing %s/%s from %q", rec.Tag, subRec.Tag, in)
 			}
@@ -179,6 +230,90 @@ func (cmd *readCmd) readCommand(
 	return nil
 }
 
+// inputPlugins lists the plugins to read for inPath, which is either a
+// plugin or an openmw.cfg, and selects the code page to decode them with.
+// An empty encoding falls back to the cfg's encoding= setting.
+func inputPlugins(inPath, encoding string) ([]string, error) {
+	plugins := []string{inPath}
+	if strings.EqualFold(filepath.Ext(inPath), ".cfg") {
+		env, err := cfg.Load(inPath)
+		if err != nil {
+			return nil, fmt.Errorf("%q couldn't be parsed: %w", inPath, err)
+		}
+		plugins = env.Plugins
+		if encoding == "" {
+			encoding = env.Encoding
+		}
+	}
+	if encoding != "" {
+		enc, err := codepage.ByName(encoding)
+		if err != nil {
+			return nil, err
+		}
+		codepage.Set(enc)
+	}
+	return plugins, nil
+}
+
+// loadPlugins parses plugins in parallel, showing progress on a terminal.
+// Only the records keep selects are kept, or all if it is nil.
+// Interrupting stops loading.
+func loadPlugins(plugins []string, jobs int, keep func(*esm.Record) bool, parse ...esm.ParseOption) ([]*esm.Plugin, error) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	opts := esm.LoadOptions{Workers: jobs, Parse: parse, Keep: keep}
+	if len(plugins) > 1 && term.IsTerminal(int(os.Stderr.Fd())) {
+		opts.Progress = func(done, total int, path string, _ error) {
+			fmt.Fprintf(os.Stderr, "\rLoaded %d/%d plugins", done, total)
+			if done == total {
+				fmt.Fprintln(os.Stderr)
+			}
+		}
+	}
+	return esm.LoadPlugins(ctx, plugins, opts)
+}
+
+// collectDiagnostics appends diagnostics to dst. It is safe to share
+// between the goroutines of loadPlugins.
+func collectDiagnostics(dst *[]esm.Diagnostic) esm.ParseOption {
+	var mux sync.Mutex
+	return esm.WithDiagnostics(func(d esm.Diagnostic) {
+		mux.Lock()
+		defer mux.Unlock()
+		*dst = append(*dst, d)
+	})
+}
+
+// printDiagnostics prints diagnostics in load order, then by offset.
+func printDiagnostics(plugins []string, diagnostics []esm.Diagnostic) {
+	order := map[string]int{}
+	for i, p := range plugins {
+		order[strings.ToLower(filepath.Base(p))] = i
+	}
+	slices.SortStableFunc(diagnostics, func(a, b esm.Diagnostic) int {
+		return cmp.Or(cmp.Compare(order[a.Plugin], order[b.Plugin]), cmp.Compare(a.Offset, b.Offset))
+	})
+	for _, d := range diagnostics {
+		fmt.Printf("🩹 %v\n", d)
+	}
+}
+
+// printLoadErrors prints each failure joined into err by loadPlugins.
+func printLoadErrors(err error) {
+	errs := []error{err}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs = joined.Unwrap()
+	}
+	for _, err := range errs {
+		var pluginErr *esm.PluginError
+		if errors.As(err, &pluginErr) {
+			fmt.Printf("💀 Failed parsing %s: %v\n", pluginErr.Path, pluginErr.Err)
+		} else {
+			fmt.Printf("💀 Failed: %v\n", err)
+		}
+	}
+}
+
 // printHex prints binary data with ASCII row above hex row (terminal-friendly).
 func printHex(width int, dump []byte) error {
 	// Each byte = "xx " -> 3 columns
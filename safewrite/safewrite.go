@@ -0,0 +1,247 @@
+// Package safewrite replaces files atomically, optionally keeping backups.
+//
+// New content is written to a temporary file next to the target, synced to
+// disk, and renamed over the target, so a failure part-way through never
+// leaves a truncated plugin or openmw.cfg behind.
+package safewrite
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// BackupMode selects how the previous version of a file is preserved.
+type BackupMode int
+
+const (
+	// BackupNone keeps no copy of the previous version.
+	BackupNone BackupMode = iota
+	// BackupBak copies the previous version to "<path>.bak", replacing any
+	// older .bak.
+	BackupBak
+	// BackupTimestamped copies the previous version into a backup directory
+	// under a timestamped name.
+	BackupTimestamped
+)
+
+var backupModeNames = map[BackupMode]string{
+	BackupNone:        "none",
+	BackupBak:         "bak",
+	BackupTimestamped: "timestamped",
+}
+
+func (m BackupMode) String() string {
+	if name, ok := backupModeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("BackupMode(%d)", int(m))
+}
+
+// ParseBackupMode parses "none", "bak" or "timestamped".
+func ParseBackupMode(s string) (BackupMode, error) {
+	for mode, name := range backupModeNames {
+		if strings.EqualFold(strings.TrimSpace(s), name) {
+			return mode, nil
+		}
+	}
+	return BackupNone, fmt.Errorf("unknown backup mode %q (want none, bak or timestamped)", s)
+}
+
+// DefaultBackupDir is the directory, relative to the target file, that
+// timestamped backups go in when BackupPolicy.Dir is empty.
+const DefaultBackupDir = "backups"
+
+// timestampFormat sorts lexically in chronological order.
+const timestampFormat = "20060102-150405.000000000"
+
+// BackupPolicy describes how to back up a file before it's replaced.
+type BackupPolicy struct {
+	Mode BackupMode
+	// Dir holds timestamped backups. Relative paths are relative to the
+	// directory of the file being replaced. Defaults to DefaultBackupDir.
+	Dir string
+	// Keep is the number of timestamped backups of each file to retain.
+	// Older backups are deleted. Zero or less keeps them all.
+	Keep int
+}
+
+// newFilePerm is the mode of files WriteFile creates, the one os.Create
+// gives under the usual umask of 022.
+const newFilePerm os.FileMode = 0644
+
+// WriteFile atomically replaces the file at path with whatever write
+// produces. If path already exists, it is first backed up according to
+// policy and the new file keeps its mode; otherwise the file is created
+// with mode 0644. Returns the path of the backup, or "" if none was made.
+func WriteFile(path string, policy BackupPolicy, write func(w io.Writer) error) (string, error) {
+	return writeFile(path, policy, 0, write)
+}
+
+// writeFile is WriteFile, but gives the file mode perm if it is nonzero.
+func writeFile(path string, policy BackupPolicy, perm os.FileMode, write func(w io.Writer) error) (string, error) {
+	dir := filepath.Dir(path)
+	info, err := os.Stat(path)
+	exists := err == nil
+	if exists {
+		if !info.Mode().IsRegular() {
+			return "", fmt.Errorf("%q is not a regular file", path)
+		}
+		if perm == 0 {
+			perm = info.Mode().Perm()
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("stat %q: %w", path, err)
+	}
+	if perm == 0 {
+		perm = newFilePerm
+	}
+
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return "", fmt.Errorf("create temporary file for %q: %w", path, err)
+	}
+	tmpPath := tmp.Name()
+	committed := false
+	defer func() {
+		if !committed {
+			tmp.Close()
+			os.Remove(tmpPath)
+		}
+	}()
+
+	bw := bufio.NewWriter(tmp)
+	if err := write(bw); err != nil {
+		return "", err
+	}
+	if err := bw.Flush(); err != nil {
+		return "", fmt.Errorf("write %q: %w", tmpPath, err)
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		return "", fmt.Errorf("chmod %q: %w", tmpPath, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return "", fmt.Errorf("sync %q: %w", tmpPath, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("close %q: %w", tmpPath, err)
+	}
+
+	backupPath := ""
+	if exists {
+		backupPath, err = Backup(path, policy)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return backupPath, fmt.Errorf("replace %q: %w", path, err)
+	}
+	committed = true
+	syncDir(dir)
+	return backupPath, nil
+}
+
+// Backup copies the file at path according to policy and returns the path
+// of the copy, or "" if policy.Mode is BackupNone.
+func Backup(path string, policy BackupPolicy) (string, error) {
+	var backupPath string
+	switch policy.Mode {
+	case BackupNone:
+		return "", nil
+	case BackupBak:
+		backupPath = path + ".bak"
+	case BackupTimestamped:
+		backupDir := policy.Dir
+		if backupDir == "" {
+			backupDir = DefaultBackupDir
+		}
+		if !filepath.IsAbs(backupDir) {
+			backupDir = filepath.Join(filepath.Dir(path), backupDir)
+		}
+		if err := os.MkdirAll(backupDir, 0777); err != nil {
+			return "", fmt.Errorf("create backup dir %q: %w", backupDir, err)
+		}
+		backupPath = filepath.Join(backupDir, filepath.Base(path)+"."+time.Now().Format(timestampFormat))
+	default:
+		return "", fmt.Errorf("unknown backup mode %v", policy.Mode)
+	}
+
+	if err := copyFile(path, backupPath); err != nil {
+		return "", fmt.Errorf("back up %q: %w", path, err)
+	}
+
+	if policy.Mode == BackupTimestamped && policy.Keep > 0 {
+		if err := prune(filepath.Dir(backupPath), filepath.Base(path), policy.Keep); err != nil {
+			return backupPath, fmt.Errorf("prune backups of %q: %w", path, err)
+		}
+	}
+	return backupPath, nil
+}
+
+// copyFile copies src to dst, with the mode of src, through a temporary
+// file so that dst is never left partially written.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	_, err = writeFile(dst, BackupPolicy{Mode: BackupNone}, info.Mode().Perm(), func(w io.Writer) error {
+		_, err := io.Copy(w, in)
+		return err
+	})
+	return err
+}
+
+// prune deletes all but the newest keep timestamped backups of base in dir.
+func prune(dir, base string, keep int) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var backups []string
+	for _, e := range entries {
+		stamp, ok := strings.CutPrefix(e.Name(), base+".")
+		if !ok || e.IsDir() {
+			continue
+		}
+		if _, err := time.Parse(timestampFormat, stamp); err != nil {
+			continue
+		}
+		backups = append(backups, e.Name())
+	}
+	if len(backups) <= keep {
+		return nil
+	}
+	slices.Sort(backups)
+	var errs []error
+	for _, name := range backups[:len(backups)-keep] {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// syncDir flushes a directory entry update to disk where the platform
+// supports it. Failures are ignored; the rename itself already succeeded.
+func syncDir(dir string) {
+	d, err := os.Open(dir)
+	if err != nil {
+		return
+	}
+	defer d.Close()
+	_ = d.Sync()
+}
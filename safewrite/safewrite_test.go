@@ -0,0 +1,126 @@
+package safewrite
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeString(s string) func(w io.Writer) error {
+	return func(w io.Writer) error {
+		_, err := io.WriteString(w, s)
+		return err
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "plugin.omwaddon")
+
+	backupPath, err := WriteFile(target, BackupPolicy{Mode: BackupBak}, writeString("first"))
+	require.NoError(t, err)
+	require.Empty(t, backupPath, "nothing to back up yet")
+	raw, err := os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, "first", string(raw))
+
+	backupPath, err = WriteFile(target, BackupPolicy{Mode: BackupBak}, writeString("second"))
+	require.NoError(t, err)
+	require.Equal(t, target+".bak", backupPath)
+	raw, err = os.ReadFile(target)
+	require.NoError(t, err)
+	require.Equal(t, "second", string(raw))
+	raw, err = os.ReadFile(backupPath)
+	require.NoError(t, err)
+	require.Equal(t, "first", string(raw))
+
+	t.Run("failed write keeps original", func(t *testing.T) {
+		failure := errors.New("boom")
+		_, err := WriteFile(target, BackupPolicy{Mode: BackupBak}, func(w io.Writer) error {
+			if _, err := io.WriteString(w, "partial"); err != nil {
+				return err
+			}
+			return failure
+		})
+		require.ErrorIs(t, err, failure)
+		raw, err := os.ReadFile(target)
+		require.NoError(t, err)
+		require.Equal(t, "second", string(raw))
+
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		names := []string{}
+		for _, e := range entries {
+			names = append(names, e.Name())
+		}
+		require.ElementsMatch(t, []string{"plugin.omwaddon", "plugin.omwaddon.bak"}, names)
+	})
+}
+
+func TestTimestampedBackups(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "openmw.cfg")
+	policy := BackupPolicy{Mode: BackupTimestamped, Keep: 2}
+
+	require.NoError(t, os.WriteFile(target, []byte("0"), 0644))
+	var backups []string
+	for _, content := range []string{"1", "2", "3"} {
+		backupPath, err := WriteFile(target, policy, writeString(content))
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(dir, DefaultBackupDir), filepath.Dir(backupPath))
+		backups = append(backups, backupPath)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(dir, DefaultBackupDir))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.NoFileExists(t, backups[0])
+	raw, err := os.ReadFile(backups[2])
+	require.NoError(t, err)
+	require.Equal(t, "2", string(raw))
+
+	info, err := os.Stat(target)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0644), info.Mode().Perm())
+}
+
+func TestFileMode(t *testing.T) {
+	dir := t.TempDir()
+	target := filepath.Join(dir, "merged.omwaddon")
+
+	_, err := WriteFile(target, BackupPolicy{Mode: BackupBak}, writeString("new"))
+	require.NoError(t, err)
+	info, err := os.Stat(target)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0644), info.Mode().Perm())
+
+	// Replacements and their backups keep the mode of the file replaced.
+	require.NoError(t, os.Chmod(target, 0600))
+	backupPath, err := WriteFile(target, BackupPolicy{Mode: BackupBak}, writeString("newer"))
+	require.NoError(t, err)
+	for _, path := range []string{target, backupPath} {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.Equal(t, os.FileMode(0600), info.Mode().Perm(), path)
+	}
+
+	backupPath, err = Backup(target, BackupPolicy{Mode: BackupTimestamped})
+	require.NoError(t, err)
+	info, err = os.Stat(backupPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestParseBackupMode(t *testing.T) {
+	for _, mode := range []BackupMode{BackupNone, BackupBak, BackupTimestamped} {
+		parsed, err := ParseBackupMode(mode.String())
+		require.NoError(t, err)
+		require.Equal(t, mode, parsed)
+	}
+	_, err := ParseBackupMode("zip")
+	require.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ernmw/omwpacker/esm"
+	"github.com/spf13/pflag"
+	"go.coder.com/cli"
+)
+
+// validateCmd checks that plugins parse, down to every registered typed
+// record and subrecord.
+type validateCmd struct {
+	lenient  bool   // --lenient
+	encoding string // -e
+	jobs     int    // -j
+}
+
+func (cmd *validateCmd) Spec() cli.CommandSpec {
+	return cli.CommandSpec{
+		Name:    "validate",
+		Usage:   "<input> [--lenient] [-e encoding] [-j jobs]",
+		Aliases: []string{"v"},
+		Desc:    "Check that an .omwaddon/.esp/.esm, or every plugin in an openmw.cfg, parses cleanly.",
+	}
+}
+
+func (cmd *validateCmd) RegisterFlags(fl *pflag.FlagSet) {
+	fl.BoolVar(&cmd.lenient, "lenient", false, "List every problem a lenient parser can recover from, instead of stopping at the first problem in each plugin.")
+	fl.StringVarP(&cmd.encoding, "encoding", "e", "", "Code page of plugin strings: win1250, win1251 or win1252. Defaults to the cfg's encoding= setting, or win1252.")
+	fl.IntVarP(&cmd.jobs, "jobs", "j", 0, "Number of plugins to parse at once. Defaults to the number of CPUs.")
+}
+
+func (cmd *validateCmd) Run(fl *pflag.FlagSet) {
+	if fl.NArg() < 1 {
+		fl.Usage()
+		fmt.Fprintln(os.Stderr, "input file required")
+		os.Exit(2)
+	}
+	inPath := fl.Arg(0)
+
+	if !fileExists(inPath) {
+		fmt.Printf("💀 Failed: File %q not found\n", inPath)
+		os.Exit(1)
+	}
+
+	plugins, err := inputPlugins(inPath, cmd.encoding)
+	if err != nil {
+		fmt.Printf("💀 Failed: %v\n", err)
+		os.Exit(1)
+	}
+
+	var diagnostics []esm.Diagnostic
+	parse := []esm.ParseOption{}
+	if cmd.lenient {
+		parse = append(parse, esm.WithLenient(), collectDiagnostics(&diagnostics))
+	}
+	loaded, loadErr := loadPlugins(plugins, cmd.jobs, nil, parse...)
+
+	failed := 0
+	for _, plugin := range loaded {
+		if plugin.Records == nil {
+			continue
+		}
+		if err := validateRecords(plugin.Records, parse...); err != nil {
+			fmt.Printf("💀 Invalid %s: %v\n", plugin.Path, err)
+			failed++
+		}
+	}
+
+	printDiagnostics(plugins, diagnostics)
+	if loadErr != nil {
+		printLoadErrors(loadErr)
+		os.Exit(1)
+	}
+	if failed > 0 || len(diagnostics) > 0 {
+		fmt.Printf("💀 Found problems in %q\n", inPath)
+		os.Exit(1)
+	}
+	fmt.Printf("🩷 %q is valid\n", inPath)
+}
+
+// validateRecords decodes every record with a registered typed record,
+// and every registered subrecord of the others. Strict options return the
+// first problem.
+func validateRecords(records []*esm.Record, opts ...esm.ParseOption) error {
+	o := esm.NewParseOptions(opts...)
+	for _, rec := range records {
+		_, err := esm.Decode(rec, opts...)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, esm.ErrNotRegistered) {
+			return fmt.Errorf("record at 0x%x: %w", rec.PluginOffset, err)
+		}
+		for i, sub := range rec.Subrecords {
+			p, err := esm.NewSubrecord(rec.Tag, sub.Tag)
+			if err != nil {
+				continue
+			}
+			if _, err := o.Unmarshal(rec, i, p); err != nil {
+				return fmt.Errorf("%s.%s at 0x%x: %w", rec.Tag, sub.Tag, esm.SubrecordOffset(rec, i), err)
+			}
+		}
+	}
+	return nil
+}